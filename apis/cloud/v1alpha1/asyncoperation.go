@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// GetAsyncOperation returns the asynchronous operation of the CloudApiKey.
+func (mg *CloudApiKey) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudMetricsEndpoint.
+func (mg *CloudMetricsEndpoint) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudNamespace.
+func (mg *CloudNamespace) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudNamespaceAccess.
+func (mg *CloudNamespaceAccess) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudNamespaceExportSink.
+func (mg *CloudNamespaceExportSink) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudNexusEndpoint.
+func (mg *CloudNexusEndpoint) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudServiceAccount.
+func (mg *CloudServiceAccount) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudUser.
+func (mg *CloudUser) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
+
+// GetAsyncOperation returns the asynchronous operation of the CloudUserGroup.
+func (mg *CloudUserGroup) GetAsyncOperation() *apisv1alpha1.AsyncOperationStatus {
+	return &mg.Status.AsyncOperation
+}
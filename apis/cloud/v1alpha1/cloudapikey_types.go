@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudApiKeyParameters are the configurable fields of a CloudApiKey.
+// +kubebuilder:validation:XValidation:rule="has(self.serviceAccountId) || has(self.serviceAccountIdRef) || has(self.serviceAccountIdSelector) || has(self.userId) || has(self.userIdRef) || has(self.userIdSelector)",message="Either a service account or a user is required as owner"
+type CloudApiKeyParameters struct {
+	// DisplayName of the API key
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	DisplayName string `json:"displayName"`
+
+	// Description of the API key
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// ServiceAccountId of the service account, which owns the API key (immutable)
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ServiceAccountId is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudServiceAccount
+	ServiceAccountId *string `json:"serviceAccountId,omitempty"`
+
+	// ServiceAccountIdRef references a CloudServiceAccount and retrieves its id
+	// +optional
+	ServiceAccountIdRef *xpv1.Reference `json:"serviceAccountIdRef,omitempty"`
+
+	// ServiceAccountIdSelector selects a reference to a CloudServiceAccount and retrieves its id
+	// +optional
+	ServiceAccountIdSelector *xpv1.Selector `json:"serviceAccountIdSelector,omitempty"`
+
+	// UserId of the user, which owns the API key (immutable)
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="UserId is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudUser
+	UserId *string `json:"userId,omitempty"`
+
+	// UserIdRef references a CloudUser and retrieves its id
+	// +optional
+	UserIdRef *xpv1.Reference `json:"userIdRef,omitempty"`
+
+	// UserIdSelector selects a reference to a CloudUser and retrieves its id
+	// +optional
+	UserIdSelector *xpv1.Selector `json:"userIdSelector,omitempty"`
+
+	// ExpiryTime of the API key (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ExpiryTime is immutable"
+	ExpiryTime metav1.Time `json:"expiryTime"`
+
+	// Disabled API keys can not be used to authenticate
+	// +optional
+	// +kubebuilder:default=false
+	Disabled bool `json:"disabled"`
+}
+
+// CloudApiKeyObservation are the observable fields of a CloudApiKey.
+type CloudApiKeyObservation struct {
+	// Id of the API key, which is assigned by Temporal Cloud.
+	Id string `json:"id"`
+
+	DisplayName string `json:"displayName"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// OwnerType is either ServiceAccount or User.
+	OwnerType string `json:"ownerType"`
+
+	OwnerId string `json:"ownerId"`
+
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty"`
+
+	Disabled bool `json:"disabled"`
+
+	// State of the API key, e.g. active, updating or deleting.
+	State string `json:"state"`
+
+	// ResourceVersion of the API key, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// A CloudApiKeySpec defines the desired state of a CloudApiKey.
+type CloudApiKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudApiKeyParameters `json:"forProvider"`
+}
+
+// A CloudApiKeyStatus represents the observed state of a CloudApiKey.
+type CloudApiKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudApiKeyObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudApiKey is an API key of a Temporal Cloud service account or user,
+// which is managed by the Temporal Cloud Operations API. The key is published
+// as connection detail, when it is created.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EXPIRY",type="string",JSONPath=".spec.forProvider.expiryTime"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudApiKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudApiKeySpec   `json:"spec"`
+	Status CloudApiKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudApiKeyList contains a list of CloudApiKey
+type CloudApiKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudApiKey `json:"items"`
+}
+
+// CloudApiKey type metadata.
+var (
+	CloudApiKeyKind             = reflect.TypeOf(CloudApiKey{}).Name()
+	CloudApiKeyGroupKind        = schema.GroupKind{Group: Group, Kind: CloudApiKeyKind}.String()
+	CloudApiKeyKindAPIVersion   = CloudApiKeyKind + "." + SchemeGroupVersion.String()
+	CloudApiKeyGroupVersionKind = SchemeGroupVersion.WithKind(CloudApiKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudApiKey{}, &CloudApiKeyList{})
+}
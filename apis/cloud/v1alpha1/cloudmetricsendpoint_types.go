@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudMetricsEndpointParameters are the configurable fields of a
+// CloudMetricsEndpoint.
+// +kubebuilder:validation:XValidation:rule="has(self.acceptedClientCa) != has(self.acceptedClientCaSecretRef)",message="Exactly one of acceptedClientCa or acceptedClientCaSecretRef is required"
+type CloudMetricsEndpointParameters struct {
+	// AcceptedClientCa is the PEM encoded CA bundle, which the client
+	// certificates of metrics scrapers must be signed by.
+	// +optional
+	AcceptedClientCa string `json:"acceptedClientCa,omitempty"`
+
+	// AcceptedClientCaSecretRef references a key of a secret, which contains
+	// the PEM encoded CA bundle. Changes of the secret are applied on the next
+	// poll.
+	// +optional
+	AcceptedClientCaSecretRef *xpv1.SecretKeySelector `json:"acceptedClientCaSecretRef,omitempty"`
+}
+
+// CloudMetricsEndpointObservation are the observable fields of a
+// CloudMetricsEndpoint.
+type CloudMetricsEndpointObservation struct {
+	// AccountId of the Temporal Cloud account.
+	AccountId string `json:"accountId"`
+
+	// +optional
+	AcceptedClientCa string `json:"acceptedClientCa,omitempty"`
+
+	// Uri of the Prometheus endpoint of the account.
+	// +optional
+	Uri string `json:"uri,omitempty"`
+
+	// State of the account, e.g. active or updating.
+	State string `json:"state"`
+
+	// ResourceVersion of the account, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// A CloudMetricsEndpointSpec defines the desired state of a
+// CloudMetricsEndpoint.
+type CloudMetricsEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudMetricsEndpointParameters `json:"forProvider"`
+}
+
+// A CloudMetricsEndpointStatus represents the observed state of a
+// CloudMetricsEndpoint.
+type CloudMetricsEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudMetricsEndpointObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudMetricsEndpoint enables the Prometheus metrics endpoint of a Temporal
+// Cloud account, which is managed by the Temporal Cloud Operations API. There
+// is only one metrics endpoint per account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.uri"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudMetricsEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudMetricsEndpointSpec   `json:"spec"`
+	Status CloudMetricsEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudMetricsEndpointList contains a list of CloudMetricsEndpoint
+type CloudMetricsEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudMetricsEndpoint `json:"items"`
+}
+
+// CloudMetricsEndpoint type metadata.
+var (
+	CloudMetricsEndpointKind             = reflect.TypeOf(CloudMetricsEndpoint{}).Name()
+	CloudMetricsEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: CloudMetricsEndpointKind}.String()
+	CloudMetricsEndpointKindAPIVersion   = CloudMetricsEndpointKind + "." + SchemeGroupVersion.String()
+	CloudMetricsEndpointGroupVersionKind = SchemeGroupVersion.WithKind(CloudMetricsEndpointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudMetricsEndpoint{}, &CloudMetricsEndpointList{})
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudNamespaceParameters are the configurable fields of a CloudNamespace.
+// +kubebuilder:validation:XValidation:rule="self.authMethod != 'Mtls' || has(self.mtlsAuth)", message="mtlsAuth is required, if authMethod is Mtls"
+type CloudNamespaceParameters struct {
+	// Name of the namespace without the account id suffix (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=39
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable"
+	Name string `json:"name"`
+
+	// Regions in which the namespace is hosted, e.g. aws-us-east-1. The first
+	// region is the primary region and can not be changed. Further regions
+	// replicate the namespace and can be added and removed.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:XValidation:rule="self[0] == oldSelf[0]",message="The primary region is immutable"
+	Regions []string `json:"regions"`
+
+	// RetentionDays of closed workflows
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=90
+	// +kubebuilder:default=30
+	RetentionDays int32 `json:"retentionDays"`
+
+	// AuthMethod used by clients to connect to the namespace.
+	// +kubebuilder:validation:Enum=ApiKey;Mtls
+	// +kubebuilder:default=ApiKey
+	AuthMethod string `json:"authMethod"`
+
+	// MtlsAuth configures the mTLS authentication, if authMethod is Mtls.
+	// +optional
+	MtlsAuth *CloudNamespaceMtlsAuth `json:"mtlsAuth,omitempty"`
+
+	// CustomSearchAttributes of the namespace by name with their type. Temporal
+	// Cloud does not support to remove custom search attributes or to change
+	// their type. The custom search attributes are not managed, if unset.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] in ['Bool', 'Datetime', 'Double', 'Int', 'Keyword', 'KeywordList', 'Text'])",message="Type must be one of Bool, Datetime, Double, Int, Keyword, KeywordList or Text"
+	// +kubebuilder:validation:XValidation:rule="oldSelf.all(k, k in self && self[k] == oldSelf[k])",message="Custom search attributes can not be removed or changed"
+	CustomSearchAttributes map[string]string `json:"customSearchAttributes,omitempty"`
+}
+
+// CloudNamespaceMtlsAuth configures the mTLS authentication of a CloudNamespace.
+// +kubebuilder:validation:XValidation:rule="has(self.acceptedClientCa) != has(self.acceptedClientCaSecretRef)",message="Exactly one of acceptedClientCa or acceptedClientCaSecretRef is required"
+type CloudNamespaceMtlsAuth struct {
+	// AcceptedClientCa is the PEM encoded CA bundle, which client certificates
+	// must be signed by.
+	// +optional
+	AcceptedClientCa string `json:"acceptedClientCa,omitempty"`
+
+	// AcceptedClientCaSecretRef references a key of a secret, which contains
+	// the PEM encoded CA bundle, e.g. the ca.crt of a cert-manager
+	// certificate. Changes of the secret are applied on the next poll.
+	// +optional
+	AcceptedClientCaSecretRef *xpv1.SecretKeySelector `json:"acceptedClientCaSecretRef,omitempty"`
+
+	// CertificateFilters restrict the accepted client certificates to those,
+	// which match at least one filter. All client certificates signed by the
+	// CA bundle are accepted, if no filter is set.
+	// +optional
+	// +kubebuilder:validation:MaxItems=25
+	CertificateFilters []CloudNamespaceCertificateFilter `json:"certificateFilters,omitempty"`
+}
+
+// CloudNamespaceCertificateFilter matches client certificates, whose subject
+// matches all set fields.
+type CloudNamespaceCertificateFilter struct {
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// +optional
+	Organization string `json:"organization,omitempty"`
+
+	// +optional
+	OrganizationalUnit string `json:"organizationalUnit,omitempty"`
+
+	// +optional
+	SubjectAlternativeName string `json:"subjectAlternativeName,omitempty"`
+}
+
+// CloudNamespaceObservation are the observable fields of a CloudNamespace.
+type CloudNamespaceObservation struct {
+	// Namespace is the id of the namespace, i.e. the name with the account
+	// id suffix.
+	Namespace string `json:"namespace"`
+
+	Name string `json:"name"`
+
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+
+	RetentionDays int32 `json:"retentionDays"`
+
+	AuthMethod string `json:"authMethod"`
+
+	// +optional
+	MtlsAuth *CloudNamespaceMtlsAuth `json:"mtlsAuth,omitempty"`
+
+	// +optional
+	CustomSearchAttributes map[string]string `json:"customSearchAttributes,omitempty"`
+
+	// State of the namespace, e.g. active, updating or deleting.
+	State string `json:"state"`
+
+	// ResourceVersion of the namespace, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// ActiveRegion of the namespace.
+	// +optional
+	ActiveRegion string `json:"activeRegion,omitempty"`
+
+	// RegionStatus reports the replication state of each region.
+	// +optional
+	RegionStatus []CloudNamespaceRegionStatus `json:"regionStatus,omitempty"`
+
+	// GrpcAddress of the namespace for clients using API keys.
+	// +optional
+	GrpcAddress string `json:"grpcAddress,omitempty"`
+
+	// MtlsGrpcAddress of the namespace for clients using mTLS.
+	// +optional
+	MtlsGrpcAddress string `json:"mtlsGrpcAddress,omitempty"`
+
+	// WebAddress of the namespace in the Temporal Cloud UI.
+	// +optional
+	WebAddress string `json:"webAddress,omitempty"`
+}
+
+// CloudNamespaceRegionStatus is the replication state of a region of a
+// CloudNamespace.
+type CloudNamespaceRegionStatus struct {
+	Region string `json:"region"`
+
+	// State of the region, e.g. adding, active or removing.
+	State string `json:"state"`
+}
+
+// A CloudNamespaceSpec defines the desired state of a CloudNamespace.
+type CloudNamespaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudNamespaceParameters `json:"forProvider"`
+}
+
+// A CloudNamespaceStatus represents the observed state of a CloudNamespace.
+type CloudNamespaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudNamespaceObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudNamespace is a namespace of Temporal Cloud, which is managed by the
+// Temporal Cloud Operations API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudNamespace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudNamespaceSpec   `json:"spec"`
+	Status CloudNamespaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudNamespaceList contains a list of CloudNamespace
+type CloudNamespaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudNamespace `json:"items"`
+}
+
+// CloudNamespace type metadata.
+var (
+	CloudNamespaceKind             = reflect.TypeOf(CloudNamespace{}).Name()
+	CloudNamespaceGroupKind        = schema.GroupKind{Group: Group, Kind: CloudNamespaceKind}.String()
+	CloudNamespaceKindAPIVersion   = CloudNamespaceKind + "." + SchemeGroupVersion.String()
+	CloudNamespaceGroupVersionKind = SchemeGroupVersion.WithKind(CloudNamespaceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudNamespace{}, &CloudNamespaceList{})
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudNamespaceAccessParameters are the configurable fields of a
+// CloudNamespaceAccess.
+// +kubebuilder:validation:XValidation:rule="[has(self.userId) || has(self.userIdRef) || has(self.userIdSelector), has(self.userGroupId) || has(self.userGroupIdRef) || has(self.userGroupIdSelector), has(self.serviceAccountId) || has(self.serviceAccountIdRef) || has(self.serviceAccountIdSelector)].filter(x, x).size() == 1",message="Exactly one of a user, a user group or a service account is required"
+type CloudNamespaceAccessParameters struct {
+	// Namespace is the id of the namespace, i.e. the name with the account
+	// id suffix, on which the permission is granted (immutable)
+	// At least one of namespace, namespaceRef or namespaceSelector is required.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Namespace is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudNamespace
+	Namespace *string `json:"namespace,omitempty"`
+
+	// NamespaceRef references a CloudNamespace and retrieves its id
+	// +optional
+	NamespaceRef *xpv1.Reference `json:"namespaceRef,omitempty"`
+
+	// NamespaceSelector selects a reference to a CloudNamespace and retrieves its id
+	// +optional
+	NamespaceSelector *xpv1.Selector `json:"namespaceSelector,omitempty"`
+
+	// UserId of the user, which is granted the permission (immutable)
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="UserId is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudUser
+	UserId *string `json:"userId,omitempty"`
+
+	// UserIdRef references a CloudUser and retrieves its id
+	// +optional
+	UserIdRef *xpv1.Reference `json:"userIdRef,omitempty"`
+
+	// UserIdSelector selects a reference to a CloudUser and retrieves its id
+	// +optional
+	UserIdSelector *xpv1.Selector `json:"userIdSelector,omitempty"`
+
+	// UserGroupId of the group, which is granted the permission (immutable)
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="UserGroupId is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudUserGroup
+	UserGroupId *string `json:"userGroupId,omitempty"`
+
+	// UserGroupIdRef references a CloudUserGroup and retrieves its id
+	// +optional
+	UserGroupIdRef *xpv1.Reference `json:"userGroupIdRef,omitempty"`
+
+	// UserGroupIdSelector selects a reference to a CloudUserGroup and retrieves its id
+	// +optional
+	UserGroupIdSelector *xpv1.Selector `json:"userGroupIdSelector,omitempty"`
+
+	// ServiceAccountId of the service account, which is granted the permission (immutable)
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ServiceAccountId is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudServiceAccount
+	ServiceAccountId *string `json:"serviceAccountId,omitempty"`
+
+	// ServiceAccountIdRef references a CloudServiceAccount and retrieves its id
+	// +optional
+	ServiceAccountIdRef *xpv1.Reference `json:"serviceAccountIdRef,omitempty"`
+
+	// ServiceAccountIdSelector selects a reference to a CloudServiceAccount and retrieves its id
+	// +optional
+	ServiceAccountIdSelector *xpv1.Selector `json:"serviceAccountIdSelector,omitempty"`
+
+	// Permission on the namespace
+	// +kubebuilder:validation:Enum=Admin;Write;Read
+	// +kubebuilder:default=Read
+	Permission string `json:"permission"`
+}
+
+// CloudNamespaceAccessObservation are the observable fields of a
+// CloudNamespaceAccess.
+type CloudNamespaceAccessObservation struct {
+	Namespace string `json:"namespace"`
+
+	// IdentityType is either User, UserGroup or ServiceAccount.
+	IdentityType string `json:"identityType"`
+
+	IdentityId string `json:"identityId"`
+
+	Permission string `json:"permission"`
+}
+
+// A CloudNamespaceAccessSpec defines the desired state of a
+// CloudNamespaceAccess.
+type CloudNamespaceAccessSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudNamespaceAccessParameters `json:"forProvider"`
+}
+
+// A CloudNamespaceAccessStatus represents the observed state of a
+// CloudNamespaceAccess.
+type CloudNamespaceAccessStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudNamespaceAccessObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudNamespaceAccess grants a Temporal Cloud user, group or service
+// account a permission on a CloudNamespace.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PERMISSION",type="string",JSONPath=".spec.forProvider.permission"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudNamespaceAccess struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudNamespaceAccessSpec   `json:"spec"`
+	Status CloudNamespaceAccessStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudNamespaceAccessList contains a list of CloudNamespaceAccess
+type CloudNamespaceAccessList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudNamespaceAccess `json:"items"`
+}
+
+// CloudNamespaceAccess type metadata.
+var (
+	CloudNamespaceAccessKind             = reflect.TypeOf(CloudNamespaceAccess{}).Name()
+	CloudNamespaceAccessGroupKind        = schema.GroupKind{Group: Group, Kind: CloudNamespaceAccessKind}.String()
+	CloudNamespaceAccessKindAPIVersion   = CloudNamespaceAccessKind + "." + SchemeGroupVersion.String()
+	CloudNamespaceAccessGroupVersionKind = SchemeGroupVersion.WithKind(CloudNamespaceAccessKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudNamespaceAccess{}, &CloudNamespaceAccessList{})
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudNamespaceExportSinkParameters are the configurable fields of a
+// CloudNamespaceExportSink.
+// +kubebuilder:validation:XValidation:rule="has(self.s3) != has(self.gcs)",message="Exactly one of s3 or gcs is required"
+type CloudNamespaceExportSinkParameters struct {
+	// Namespace is the id of the namespace, i.e. the name with the account
+	// id suffix, whose workflow histories are exported (immutable)
+	// At least one of namespace, namespaceRef or namespaceSelector is required.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Namespace is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudNamespace
+	Namespace *string `json:"namespace,omitempty"`
+
+	// NamespaceRef references a CloudNamespace and retrieves its id
+	// +optional
+	NamespaceRef *xpv1.Reference `json:"namespaceRef,omitempty"`
+
+	// NamespaceSelector selects a reference to a CloudNamespace and retrieves its id
+	// +optional
+	NamespaceSelector *xpv1.Selector `json:"namespaceSelector,omitempty"`
+
+	// Name of the export sink (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable"
+	Name string `json:"name"`
+
+	// Enabled export sinks export the workflow histories
+	// +optional
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled"`
+
+	// S3 exports the workflow histories to an AWS S3 bucket.
+	// +optional
+	S3 *CloudExportSinkS3 `json:"s3,omitempty"`
+
+	// Gcs exports the workflow histories to a Google Cloud Storage bucket.
+	// +optional
+	Gcs *CloudExportSinkGcs `json:"gcs,omitempty"`
+}
+
+// CloudExportSinkS3 is an AWS S3 bucket, to which workflow histories are
+// exported.
+type CloudExportSinkS3 struct {
+	// RoleName of the IAM role, which Temporal Cloud assumes to write to the
+	// bucket.
+	// +kubebuilder:validation:MinLength=1
+	RoleName string `json:"roleName"`
+
+	// +kubebuilder:validation:MinLength=1
+	BucketName string `json:"bucketName"`
+
+	// Region of the bucket
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// KmsArn of the key, which encrypts the exported workflow histories.
+	// +optional
+	KmsArn string `json:"kmsArn,omitempty"`
+
+	// AwsAccountId of the account, which owns the bucket and the role.
+	// +kubebuilder:validation:MinLength=1
+	AwsAccountId string `json:"awsAccountId"`
+}
+
+// CloudExportSinkGcs is a Google Cloud Storage bucket, to which workflow
+// histories are exported.
+type CloudExportSinkGcs struct {
+	// SaId is the id of the service account, which Temporal Cloud
+	// impersonates to write to the bucket.
+	// +kubebuilder:validation:MinLength=1
+	SaId string `json:"saId"`
+
+	// +kubebuilder:validation:MinLength=1
+	BucketName string `json:"bucketName"`
+
+	// GcpProjectId of the project, which owns the bucket and the service
+	// account.
+	// +kubebuilder:validation:MinLength=1
+	GcpProjectId string `json:"gcpProjectId"`
+
+	// Region of the bucket
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// CloudNamespaceExportSinkObservation are the observable fields of a
+// CloudNamespaceExportSink.
+type CloudNamespaceExportSinkObservation struct {
+	Namespace string `json:"namespace"`
+
+	Name string `json:"name"`
+
+	Enabled bool `json:"enabled"`
+
+	// +optional
+	S3 *CloudExportSinkS3 `json:"s3,omitempty"`
+
+	// +optional
+	Gcs *CloudExportSinkGcs `json:"gcs,omitempty"`
+
+	// State of the export sink, e.g. active, updating or deleting.
+	State string `json:"state"`
+
+	// ResourceVersion of the export sink, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Health of the export sink, e.g. ok or error_user_configuration.
+	// +optional
+	Health string `json:"health,omitempty"`
+
+	// ErrorMessage explains, why the export sink is not healthy.
+	// +optional
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// LatestDataExportTime is the time of the latest export.
+	// +optional
+	LatestDataExportTime string `json:"latestDataExportTime,omitempty"`
+}
+
+// A CloudNamespaceExportSinkSpec defines the desired state of a
+// CloudNamespaceExportSink.
+type CloudNamespaceExportSinkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudNamespaceExportSinkParameters `json:"forProvider"`
+}
+
+// A CloudNamespaceExportSinkStatus represents the observed state of a
+// CloudNamespaceExportSink.
+type CloudNamespaceExportSinkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudNamespaceExportSinkObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudNamespaceExportSink exports the workflow histories of a
+// CloudNamespace to an AWS S3 or a Google Cloud Storage bucket.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="HEALTH",type="string",JSONPath=".status.atProvider.health"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudNamespaceExportSink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudNamespaceExportSinkSpec   `json:"spec"`
+	Status CloudNamespaceExportSinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudNamespaceExportSinkList contains a list of CloudNamespaceExportSink
+type CloudNamespaceExportSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudNamespaceExportSink `json:"items"`
+}
+
+// CloudNamespaceExportSink type metadata.
+var (
+	CloudNamespaceExportSinkKind             = reflect.TypeOf(CloudNamespaceExportSink{}).Name()
+	CloudNamespaceExportSinkGroupKind        = schema.GroupKind{Group: Group, Kind: CloudNamespaceExportSinkKind}.String()
+	CloudNamespaceExportSinkKindAPIVersion   = CloudNamespaceExportSinkKind + "." + SchemeGroupVersion.String()
+	CloudNamespaceExportSinkGroupVersionKind = SchemeGroupVersion.WithKind(CloudNamespaceExportSinkKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudNamespaceExportSink{}, &CloudNamespaceExportSinkList{})
+}
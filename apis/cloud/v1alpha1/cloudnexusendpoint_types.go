@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudNexusEndpointParameters are the configurable fields of a
+// CloudNexusEndpoint.
+type CloudNexusEndpointParameters struct {
+	// Name of the endpoint, which callers use to address it. It must start
+	// with a letter and contain only letters, digits and hyphens.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=200
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9\-]*[a-zA-Z0-9]$`
+	Name string `json:"name"`
+
+	// Description of the endpoint in markdown
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// TargetNamespace is the id of the namespace, i.e. the name with the
+	// account id suffix, whose workers handle the Nexus requests.
+	// At least one of targetNamespace, targetNamespaceRef or targetNamespaceSelector is required.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudNamespace
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+
+	// TargetNamespaceRef references a CloudNamespace and retrieves its id
+	// +optional
+	TargetNamespaceRef *xpv1.Reference `json:"targetNamespaceRef,omitempty"`
+
+	// TargetNamespaceSelector selects a reference to a CloudNamespace and retrieves its id
+	// +optional
+	TargetNamespaceSelector *xpv1.Selector `json:"targetNamespaceSelector,omitempty"`
+
+	// TargetTaskQueue is the task queue, which the workers of the target
+	// namespace poll for Nexus requests.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TargetTaskQueue string `json:"targetTaskQueue"`
+
+	// AllowedCallerNamespaces are the ids of the namespaces, which are
+	// permitted to call the endpoint.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudNamespace
+	AllowedCallerNamespaces []string `json:"allowedCallerNamespaces,omitempty"`
+
+	// AllowedCallerNamespaceRefs reference CloudNamespaces and retrieve their ids
+	// +optional
+	AllowedCallerNamespaceRefs []xpv1.Reference `json:"allowedCallerNamespaceRefs,omitempty"`
+
+	// AllowedCallerNamespaceSelector selects references to CloudNamespaces and retrieves their ids
+	// +optional
+	AllowedCallerNamespaceSelector *xpv1.Selector `json:"allowedCallerNamespaceSelector,omitempty"`
+}
+
+// CloudNexusEndpointObservation are the observable fields of a
+// CloudNexusEndpoint.
+type CloudNexusEndpointObservation struct {
+	// Id of the endpoint, which is assigned by Temporal Cloud.
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	TargetNamespace string `json:"targetNamespace"`
+
+	TargetTaskQueue string `json:"targetTaskQueue"`
+
+	// +optional
+	AllowedCallerNamespaces []string `json:"allowedCallerNamespaces,omitempty"`
+
+	// State of the endpoint, e.g. active, updating or deleting.
+	State string `json:"state"`
+
+	// ResourceVersion of the endpoint, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// A CloudNexusEndpointSpec defines the desired state of a CloudNexusEndpoint.
+type CloudNexusEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudNexusEndpointParameters `json:"forProvider"`
+}
+
+// A CloudNexusEndpointStatus represents the observed state of a
+// CloudNexusEndpoint.
+type CloudNexusEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudNexusEndpointObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudNexusEndpoint routes Nexus requests to a task queue of a
+// CloudNamespace, which is managed by the Temporal Cloud Operations API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudNexusEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudNexusEndpointSpec   `json:"spec"`
+	Status CloudNexusEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudNexusEndpointList contains a list of CloudNexusEndpoint
+type CloudNexusEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudNexusEndpoint `json:"items"`
+}
+
+// CloudNexusEndpoint type metadata.
+var (
+	CloudNexusEndpointKind             = reflect.TypeOf(CloudNexusEndpoint{}).Name()
+	CloudNexusEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: CloudNexusEndpointKind}.String()
+	CloudNexusEndpointKindAPIVersion   = CloudNexusEndpointKind + "." + SchemeGroupVersion.String()
+	CloudNexusEndpointGroupVersionKind = SchemeGroupVersion.WithKind(CloudNexusEndpointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudNexusEndpoint{}, &CloudNexusEndpointList{})
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudServiceAccountParameters are the configurable fields of a
+// CloudServiceAccount.
+type CloudServiceAccountParameters struct {
+	// Name of the service account
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Description of the service account
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// AccountRole of the service account.
+	// +kubebuilder:validation:Enum=Admin;Developer;FinanceAdmin;Read
+	// +kubebuilder:default=Read
+	AccountRole string `json:"accountRole"`
+}
+
+// CloudServiceAccountObservation are the observable fields of a
+// CloudServiceAccount.
+type CloudServiceAccountObservation struct {
+	// Id of the service account, which is assigned by Temporal Cloud.
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	AccountRole string `json:"accountRole"`
+
+	// NamespaceAccesses of the service account. They are not managed by the
+	// CloudServiceAccount.
+	// +optional
+	NamespaceAccesses []NamespaceAccess `json:"namespaceAccesses,omitempty"`
+
+	// State of the service account, e.g. active, updating or deleting.
+	State string `json:"state"`
+
+	// ResourceVersion of the service account, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// A CloudServiceAccountSpec defines the desired state of a
+// CloudServiceAccount.
+type CloudServiceAccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudServiceAccountParameters `json:"forProvider"`
+}
+
+// A CloudServiceAccountStatus represents the observed state of a
+// CloudServiceAccount.
+type CloudServiceAccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudServiceAccountObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudServiceAccount is a machine identity of a Temporal Cloud account,
+// which is managed by the Temporal Cloud Operations API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudServiceAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudServiceAccountSpec   `json:"spec"`
+	Status CloudServiceAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudServiceAccountList contains a list of CloudServiceAccount
+type CloudServiceAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudServiceAccount `json:"items"`
+}
+
+// CloudServiceAccount type metadata.
+var (
+	CloudServiceAccountKind             = reflect.TypeOf(CloudServiceAccount{}).Name()
+	CloudServiceAccountGroupKind        = schema.GroupKind{Group: Group, Kind: CloudServiceAccountKind}.String()
+	CloudServiceAccountKindAPIVersion   = CloudServiceAccountKind + "." + SchemeGroupVersion.String()
+	CloudServiceAccountGroupVersionKind = SchemeGroupVersion.WithKind(CloudServiceAccountKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudServiceAccount{}, &CloudServiceAccountList{})
+}
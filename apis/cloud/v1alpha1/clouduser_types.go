@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudUserParameters are the configurable fields of a CloudUser.
+type CloudUserParameters struct {
+	// Email of the user, which is invited to the account (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Email is immutable"
+	Email string `json:"email"`
+
+	// AccountRole of the user.
+	// +kubebuilder:validation:Enum=Admin;Developer;FinanceAdmin;Read
+	// +kubebuilder:default=Read
+	AccountRole string `json:"accountRole"`
+}
+
+// NamespaceAccess is the permission of an identity on a CloudNamespace.
+type NamespaceAccess struct {
+	// Namespace is the id of the namespace, i.e. the name with the account
+	// id suffix.
+	Namespace string `json:"namespace"`
+
+	// Permission on the namespace, i.e. Admin, Write or Read.
+	Permission string `json:"permission"`
+}
+
+// CloudUserObservation are the observable fields of a CloudUser.
+type CloudUserObservation struct {
+	// Id of the user, which is assigned by Temporal Cloud.
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	AccountRole string `json:"accountRole"`
+
+	// NamespaceAccesses of the user. They are not managed by the CloudUser.
+	// +optional
+	NamespaceAccesses []NamespaceAccess `json:"namespaceAccesses,omitempty"`
+
+	// State of the user, e.g. active, updating or deleting.
+	State string `json:"state"`
+
+	// ResourceVersion of the user, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// A CloudUserSpec defines the desired state of a CloudUser.
+type CloudUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudUserParameters `json:"forProvider"`
+}
+
+// A CloudUserStatus represents the observed state of a CloudUser.
+type CloudUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudUserObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudUser is a user of a Temporal Cloud account, which is managed by the
+// Temporal Cloud Operations API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".spec.forProvider.email"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudUserSpec   `json:"spec"`
+	Status CloudUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudUserList contains a list of CloudUser
+type CloudUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudUser `json:"items"`
+}
+
+// CloudUser type metadata. The group kind is not named CloudUserGroupKind
+// like the group kinds of the other types, because that is the kind of the
+// CloudUserGroup type.
+var (
+	CloudUserKind             = reflect.TypeOf(CloudUser{}).Name()
+	CloudUserKindGroupKind    = schema.GroupKind{Group: Group, Kind: CloudUserKind}.String()
+	CloudUserKindAPIVersion   = CloudUserKind + "." + SchemeGroupVersion.String()
+	CloudUserGroupVersionKind = SchemeGroupVersion.WithKind(CloudUserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudUser{}, &CloudUserList{})
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// CloudUserGroupParameters are the configurable fields of a CloudUserGroup.
+type CloudUserGroupParameters struct {
+	// DisplayName of the group
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	DisplayName string `json:"displayName"`
+
+	// AccountRole of the members of the group.
+	// +kubebuilder:validation:Enum=Admin;Developer;FinanceAdmin;Read
+	// +kubebuilder:default=Read
+	AccountRole string `json:"accountRole"`
+
+	// MemberUserIds are the ids of the users, which are members of the group.
+	// Members, which are not listed, are removed from the group.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1.CloudUser
+	MemberUserIds []string `json:"memberUserIds,omitempty"`
+
+	// MemberUserIdRefs reference CloudUsers and retrieve their ids
+	// +optional
+	MemberUserIdRefs []xpv1.Reference `json:"memberUserIdRefs,omitempty"`
+
+	// MemberUserIdSelector selects references to CloudUsers and retrieves their ids
+	// +optional
+	MemberUserIdSelector *xpv1.Selector `json:"memberUserIdSelector,omitempty"`
+}
+
+// CloudUserGroupObservation are the observable fields of a CloudUserGroup.
+type CloudUserGroupObservation struct {
+	// Id of the group, which is assigned by Temporal Cloud.
+	Id string `json:"id"`
+
+	DisplayName string `json:"displayName"`
+
+	AccountRole string `json:"accountRole"`
+
+	// +optional
+	MemberUserIds []string `json:"memberUserIds,omitempty"`
+
+	// NamespaceAccesses of the group. They are not managed by the
+	// CloudUserGroup.
+	// +optional
+	NamespaceAccesses []NamespaceAccess `json:"namespaceAccesses,omitempty"`
+
+	// State of the group, e.g. active, updating or deleting.
+	State string `json:"state"`
+
+	// ResourceVersion of the group, which is required to update it.
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// A CloudUserGroupSpec defines the desired state of a CloudUserGroup.
+type CloudUserGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudUserGroupParameters `json:"forProvider"`
+}
+
+// A CloudUserGroupStatus represents the observed state of a CloudUserGroup.
+type CloudUserGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudUserGroupObservation `json:"atProvider,omitempty"`
+
+	// AsyncOperation tracks the asynchronous operation of the last update.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudUserGroup is a group of users of a Temporal Cloud account, which is
+// managed by the Temporal Cloud Operations API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal,temporalcloud}
+type CloudUserGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudUserGroupSpec   `json:"spec"`
+	Status CloudUserGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudUserGroupList contains a list of CloudUserGroup
+type CloudUserGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudUserGroup `json:"items"`
+}
+
+// CloudUserGroup type metadata.
+var (
+	CloudUserGroupKind             = reflect.TypeOf(CloudUserGroup{}).Name()
+	CloudUserGroupGroupKind        = schema.GroupKind{Group: Group, Kind: CloudUserGroupKind}.String()
+	CloudUserGroupKindAPIVersion   = CloudUserGroupKind + "." + SchemeGroupVersion.String()
+	CloudUserGroupGroupVersionKind = SchemeGroupVersion.WithKind(CloudUserGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CloudUserGroup{}, &CloudUserGroupList{})
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NamespaceFailoverParameters are the configurable fields of a NamespaceFailover.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.temporalNamespaceName) || has(self.temporalNamespaceName)", message="TemporalNamespaceName is required once set"
+type NamespaceFailoverParameters struct {
+
+	// Global namespace which is failed over (immutable)
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="TemporalNamespaceName is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/core/v1alpha1.TemporalNamespace
+	TemporalNamespaceName *string `json:"temporalNamespaceName,omitempty"`
+
+	// Namespace reference to retrieve the name of the global namespace
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameRef *xpv1.Reference `json:"temporalNamespaceNameRef,omitempty"`
+
+	// TemporalNamespaceNameSelector selects a reference to a TemporalNamespace and retrieves its name
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameSelector *xpv1.Selector `json:"temporalNamespaceNameSelector,omitempty"`
+
+	// ActiveClusterName is the cluster, which should be active for the
+	// namespace. Changing it fails the namespace over to that cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ActiveClusterName string `json:"activeClusterName"`
+}
+
+// NamespaceFailoverObservation are the observable fields of a NamespaceFailover.
+type NamespaceFailoverObservation struct {
+	TemporalNamespaceName string `json:"temporalNamespaceName"`
+
+	ActiveClusterName string `json:"activeClusterName"`
+
+	// Clusters to which the namespace is replicated.
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+
+	IsGlobalNamespace bool `json:"isGlobalNamespace"`
+
+	// FailoverVersion of the namespace, which increases with every failover.
+	FailoverVersion int64 `json:"failoverVersion"`
+
+	// FailoverHistory of the namespace as returned by the server.
+	// +optional
+	FailoverHistory []NamespaceFailoverHistoryEntry `json:"failoverHistory,omitempty"`
+}
+
+// NamespaceFailoverHistoryEntry describes a past failover of a namespace.
+type NamespaceFailoverHistoryEntry struct {
+	// +optional
+	FailoverTime *metav1.Time `json:"failoverTime,omitempty"`
+
+	FailoverVersion int64 `json:"failoverVersion"`
+}
+
+// A NamespaceFailoverSpec defines the desired state of a NamespaceFailover.
+type NamespaceFailoverSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NamespaceFailoverParameters `json:"forProvider"`
+}
+
+// A NamespaceFailoverStatus represents the observed state of a NamespaceFailover.
+type NamespaceFailoverStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NamespaceFailoverObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NamespaceFailover manages the active cluster of a global namespace.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACTIVE-CLUSTER",type="string",JSONPath=".status.atProvider.activeClusterName"
+// +kubebuilder:printcolumn:name="FAILOVER-VERSION",type="integer",JSONPath=".status.atProvider.failoverVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+type NamespaceFailover struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NamespaceFailoverSpec   `json:"spec"`
+	Status NamespaceFailoverStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NamespaceFailoverList contains a list of NamespaceFailover
+type NamespaceFailoverList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NamespaceFailover `json:"items"`
+}
+
+// NamespaceFailover type metadata.
+var (
+	NamespaceFailoverKind             = reflect.TypeOf(NamespaceFailover{}).Name()
+	NamespaceFailoverGroupKind        = schema.GroupKind{Group: Group, Kind: NamespaceFailoverKind}.String()
+	NamespaceFailoverKindAPIVersion   = NamespaceFailoverKind + "." + SchemeGroupVersion.String()
+	NamespaceFailoverGroupVersionKind = SchemeGroupVersion.WithKind(NamespaceFailoverKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NamespaceFailover{}, &NamespaceFailoverList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RemoteClusterParameters are the configurable fields of a RemoteCluster.
+type RemoteClusterParameters struct {
+	// ClusterName of the remote cluster as configured in its cluster metadata (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ClusterName is immutable"
+	ClusterName string `json:"clusterName"`
+
+	// FrontendAddress of the remote cluster, which is used by the connected
+	// cluster for replication.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	FrontendAddress string `json:"frontendAddress"`
+
+	// EnableConnection enables the replication connection to the remote cluster.
+	// +kubebuilder:default=true
+	EnableConnection bool `json:"enableConnection"`
+}
+
+// RemoteClusterObservation are the observable fields of a RemoteCluster.
+type RemoteClusterObservation struct {
+	ClusterName string `json:"clusterName"`
+
+	FrontendAddress string `json:"frontendAddress"`
+
+	EnableConnection bool `json:"enableConnection"`
+
+	ClusterId string `json:"clusterId"`
+
+	InitialFailoverVersion int64 `json:"initialFailoverVersion"`
+
+	HistoryShardCount int32 `json:"historyShardCount"`
+}
+
+// A RemoteClusterSpec defines the desired state of a RemoteCluster.
+type RemoteClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RemoteClusterParameters `json:"forProvider"`
+}
+
+// A RemoteClusterStatus represents the observed state of a RemoteCluster.
+type RemoteClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RemoteClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RemoteCluster connects a remote cluster to the Temporal cluster for
+// multi-cluster replication.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ADDRESS",type="string",JSONPath=".status.atProvider.frontendAddress"
+// +kubebuilder:printcolumn:name="CONNECTED",type="boolean",JSONPath=".status.atProvider.enableConnection"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+type RemoteCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RemoteClusterSpec   `json:"spec"`
+	Status RemoteClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RemoteClusterList contains a list of RemoteCluster
+type RemoteClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RemoteCluster `json:"items"`
+}
+
+// RemoteCluster type metadata.
+var (
+	RemoteClusterKind             = reflect.TypeOf(RemoteCluster{}).Name()
+	RemoteClusterGroupKind        = schema.GroupKind{Group: Group, Kind: RemoteClusterKind}.String()
+	RemoteClusterKindAPIVersion   = RemoteClusterKind + "." + SchemeGroupVersion.String()
+	RemoteClusterGroupVersionKind = SchemeGroupVersion.WithKind(RemoteClusterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RemoteCluster{}, &RemoteClusterList{})
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ScheduleParameters are the configurable fields of a Schedule.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.temporalNamespaceName) || has(self.temporalNamespaceName)", message="TemporalNamespaceName is required once set"
+type ScheduleParameters struct {
+
+	// ScheduleId of the Schedule (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ScheduleId is immutable"
+	ScheduleId string `json:"scheduleId"`
+
+	// Namespace where the schedule will be created (immutable)
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="TemporalNamespaceName is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/core/v1alpha1.TemporalNamespace
+	TemporalNamespaceName *string `json:"temporalNamespaceName,omitempty"`
+
+	// Namespace reference to retrieve the namespace name, where the schedule will be created
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameRef *xpv1.Reference `json:"temporalNamespaceNameRef,omitempty"`
+
+	// TemporalNamespaceNameSelector selects a reference to a TemporalNamespace and retrieves its name
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameSelector *xpv1.Selector `json:"temporalNamespaceNameSelector,omitempty"`
+
+	// Spec describes when the action of the Schedule is taken.
+	// +kubebuilder:validation:Required
+	Spec ScheduleSpec `json:"spec"`
+
+	// Action which is taken by the Schedule.
+	// +kubebuilder:validation:Required
+	Action ScheduleAction `json:"action"`
+
+	// Policies of the Schedule.
+	// +kubebuilder:default={}
+	// +optional
+	Policies SchedulePolicies `json:"policies,omitempty"`
+
+	// State of the Schedule.
+	// +optional
+	State ScheduleState `json:"state,omitempty"`
+}
+
+// ScheduleSpec describes the times at which the action of a Schedule is taken.
+// All times matched by calendars, structuredCalendars, cronExpressions and
+// intervals are combined, excludeCalendars and excludeStructuredCalendars
+// are subtracted afterwards.
+// +kubebuilder:validation:XValidation:rule="has(self.calendars) || has(self.structuredCalendars) || has(self.cronExpressions) || has(self.intervals)",message="At least one of calendars, structuredCalendars, cronExpressions or intervals is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.startTime) || !has(self.endTime) || self.startTime < self.endTime",message="StartTime must be before endTime"
+// +kubebuilder:validation:XValidation:rule="!has(self.timezoneName) || !has(self.cronExpressions) || self.cronExpressions.all(c, !c.startsWith('CRON_TZ=') && !c.startsWith('TZ='))",message="CronExpressions must not contain a timezone if timezoneName is set"
+type ScheduleSpec struct {
+	// Calendars on which the action is taken.
+	// +optional
+	Calendars []CalendarSpec `json:"calendars,omitempty"`
+
+	// StructuredCalendars on which the action is taken.
+	// +optional
+	StructuredCalendars []StructuredCalendarSpec `json:"structuredCalendars,omitempty"`
+
+	// CronExpressions in the traditional cron format, i.e. "30 2 * * 5".
+	// Additionally "@every <interval>[/<phase>]" and the predefined
+	// expressions like "@daily" are supported.
+	// +optional
+	CronExpressions []string `json:"cronExpressions,omitempty"`
+
+	// Intervals at which the action is taken.
+	// +optional
+	Intervals []IntervalSpec `json:"intervals,omitempty"`
+
+	// ExcludeCalendars on which no action is taken.
+	// +optional
+	ExcludeCalendars []CalendarSpec `json:"excludeCalendars,omitempty"`
+
+	// ExcludeStructuredCalendars on which no action is taken.
+	// +optional
+	ExcludeStructuredCalendars []StructuredCalendarSpec `json:"excludeStructuredCalendars,omitempty"`
+
+	// StartTime before which no action is taken.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime after which no action is taken.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// Jitter is the maximal random delay, which is added to each action time.
+	// +optional
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+
+	// TimezoneName of the IANA time zone database in which calendars and cron
+	// expressions are interpreted, i.e. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimezoneName *string `json:"timezoneName,omitempty"`
+}
+
+// CalendarSpec matches times by string expressions per field. Each field
+// accepts a comma separated list of values or ranges, i.e. "1,5,10-20/2".
+type CalendarSpec struct {
+	// Second of the minute (0-59). Defaults to "0".
+	// +optional
+	Second *string `json:"second,omitempty"`
+
+	// Minute of the hour (0-59). Defaults to "0".
+	// +optional
+	Minute *string `json:"minute,omitempty"`
+
+	// Hour of the day (0-23). Defaults to "0".
+	// +optional
+	Hour *string `json:"hour,omitempty"`
+
+	// DayOfMonth (1-31). Defaults to "*".
+	// +optional
+	DayOfMonth *string `json:"dayOfMonth,omitempty"`
+
+	// Month of the year (1-12 or JAN-DEC). Defaults to "*".
+	// +optional
+	Month *string `json:"month,omitempty"`
+
+	// Year. Defaults to "*".
+	// +optional
+	Year *string `json:"year,omitempty"`
+
+	// DayOfWeek (0-6 or SUN-SAT). Defaults to "*".
+	// +optional
+	DayOfWeek *string `json:"dayOfWeek,omitempty"`
+
+	// Comment describing the calendar.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// StructuredCalendarSpec matches times by ranges per field. A field without
+// ranges uses the same default as the corresponding field of a CalendarSpec.
+type StructuredCalendarSpec struct {
+	// +optional
+	Second []CalendarRange `json:"second,omitempty"`
+
+	// +optional
+	Minute []CalendarRange `json:"minute,omitempty"`
+
+	// +optional
+	Hour []CalendarRange `json:"hour,omitempty"`
+
+	// +optional
+	DayOfMonth []CalendarRange `json:"dayOfMonth,omitempty"`
+
+	// +optional
+	Month []CalendarRange `json:"month,omitempty"`
+
+	// +optional
+	Year []CalendarRange `json:"year,omitempty"`
+
+	// +optional
+	DayOfWeek []CalendarRange `json:"dayOfWeek,omitempty"`
+
+	// Comment describing the calendar.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+}
+
+// CalendarRange matches the values from start to end (inclusive) in steps.
+// +kubebuilder:validation:XValidation:rule="!has(self.end) || self.end >= self.start",message="End must not be less than start"
+type CalendarRange struct {
+	// +kubebuilder:validation:Minimum=0
+	Start int32 `json:"start"`
+
+	// Defaults to start.
+	// +optional
+	End *int32 `json:"end,omitempty"`
+
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Step *int32 `json:"step,omitempty"`
+}
+
+// IntervalSpec matches the times which are a multiple of every, shifted by
+// phase, counted from the unix epoch.
+// +kubebuilder:validation:XValidation:rule="!has(self.phase) || duration(self.phase) < duration(self.every)",message="Phase must be less than every"
+type IntervalSpec struct {
+	// Every is the length of the interval, i.e. "1h".
+	// +kubebuilder:validation:Required
+	Every metav1.Duration `json:"every"`
+
+	// Phase shifts the interval, i.e. "15m" with every "1h" takes the
+	// action at 15 minutes past every hour.
+	// +optional
+	Phase *metav1.Duration `json:"phase,omitempty"`
+}
+
+// ScheduleAction is the action taken by a Schedule.
+type ScheduleAction struct {
+	// StartWorkflow starts a new workflow execution.
+	// +kubebuilder:validation:Required
+	StartWorkflow ScheduleStartWorkflowAction `json:"startWorkflow"`
+}
+
+// ScheduleStartWorkflowAction describes the workflow execution started by a
+// Schedule.
+type ScheduleStartWorkflowAction struct {
+	// WorkflowId of the started workflows. The timestamp of the action is
+	// appended to make it unique.
+	// +kubebuilder:validation:Required
+	WorkflowId string `json:"workflowId"`
+
+	// WorkflowType of the started workflows.
+	// +kubebuilder:validation:Required
+	WorkflowType string `json:"workflowType"`
+
+	// TaskQueue on which the started workflows are scheduled.
+	// +kubebuilder:validation:Required
+	TaskQueue string `json:"taskQueue"`
+
+	// +optional
+	WorkflowExecutionTimeout *metav1.Duration `json:"workflowExecutionTimeout,omitempty"`
+
+	// +optional
+	WorkflowRunTimeout *metav1.Duration `json:"workflowRunTimeout,omitempty"`
+
+	// +optional
+	WorkflowTaskTimeout *metav1.Duration `json:"workflowTaskTimeout,omitempty"`
+
+	// Input arguments of the started workflows. Each argument is a JSON
+	// document, i.e. "{\"key\": \"value\"}".
+	// +optional
+	Input []string `json:"input,omitempty"`
+
+	// Memo of the started workflows. Each value is a JSON document.
+	// +optional
+	Memo map[string]string `json:"memo,omitempty"`
+
+	// SearchAttributes of the started workflows. Each SearchAttribute must
+	// exist in the namespace of the Schedule with the same type.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SearchAttributes []ScheduleSearchAttribute `json:"searchAttributes,omitempty"`
+}
+
+// ScheduleSearchAttribute is a typed SearchAttribute value of a workflow.
+type ScheduleSearchAttribute struct {
+	// Name of the SearchAttribute.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Type of the SearchAttribute.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Text;Keyword;Int;Double;Bool;Datetime;KeywordList;
+	Type string `json:"type"`
+
+	// Value of the SearchAttribute as JSON document matching its type, i.e.
+	// "\"abc\"" for Keyword, "42" for Int or "[\"a\", \"b\"]" for KeywordList.
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+}
+
+// SchedulePolicies describe how the Schedule behaves in special situations.
+type SchedulePolicies struct {
+	// OverlapPolicy defines what happens, if an action is due while the
+	// workflow of a previous action is still running.
+	// +kubebuilder:default=Skip
+	// +kubebuilder:validation:Enum=Skip;BufferOne;BufferAll;CancelOther;TerminateOther;AllowAll
+	OverlapPolicy string `json:"overlapPolicy,omitempty"`
+
+	// CatchupWindow is the maximal time after which missed actions, i.e.
+	// during a server outage, are still taken.
+	// +kubebuilder:default="8760h"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('10s')",message="CatchupWindow must be at least 10s"
+	// +optional
+	CatchupWindow *metav1.Duration `json:"catchupWindow,omitempty"`
+
+	// PauseOnFailure pauses the Schedule, if a workflow started by it fails.
+	// +optional
+	PauseOnFailure bool `json:"pauseOnFailure,omitempty"`
+}
+
+// ScheduleState describes whether the Schedule takes actions.
+// +kubebuilder:validation:XValidation:rule="!has(self.remainingActions) || self.remainingActions == 0 || (has(self.limitedActions) && self.limitedActions)",message="RemainingActions requires limitedActions"
+type ScheduleState struct {
+	// Paused Schedules take no actions.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Note which is recorded when the Schedule is paused or unpaused.
+	// Changing only the note does not update the Schedule.
+	// +optional
+	Note *string `json:"note,omitempty"`
+
+	// LimitedActions limits the number of actions taken by the Schedule to
+	// remainingActions.
+	// +optional
+	LimitedActions bool `json:"limitedActions,omitempty"`
+
+	// RemainingActions is the number of actions the Schedule takes, if
+	// limitedActions is set. The server decreases it with every action, which
+	// is not reverted. Changing it sets the remaining actions again.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RemainingActions int64 `json:"remainingActions,omitempty"`
+}
+
+// ScheduleObservation are the observable fields of a Schedule.
+type ScheduleObservation struct {
+	ScheduleId string `json:"scheduleId"`
+
+	TemporalNamespaceName string `json:"temporalNamespaceName"`
+
+	// Spec as returned by the server. The server converts calendars and
+	// cronExpressions into structuredCalendars.
+	Spec ScheduleSpec `json:"spec"`
+
+	Action ScheduleAction `json:"action"`
+
+	Policies SchedulePolicies `json:"policies"`
+
+	State ScheduleState `json:"state"`
+
+	// Info about the recent and upcoming actions of the Schedule.
+	// +optional
+	Info *ScheduleInfo `json:"info,omitempty"`
+
+	// LastTriggerTime is the time at which the Schedule was triggered last
+	// by the trigger-now annotation.
+	// +optional
+	LastTriggerTime *metav1.Time `json:"lastTriggerTime,omitempty"`
+
+	// AppliedRemainingActions is the remainingActions, which were applied
+	// last. The observed remainingActions decrease with every action and can
+	// therefore not be compared with the desired remainingActions.
+	// +optional
+	AppliedRemainingActions *int64 `json:"appliedRemainingActions,omitempty"`
+
+	// AppliedCalendarsHash is the hash of the calendars, structuredCalendars,
+	// cronExpressions, excludeCalendars and excludeStructuredCalendars, which
+	// were applied last. These fields are normalized by the server and can
+	// therefore not be compared with the observed spec.
+	// +optional
+	AppliedCalendarsHash string `json:"appliedCalendarsHash,omitempty"`
+}
+
+// ScheduleInfo describes the recent and upcoming actions of a Schedule.
+type ScheduleInfo struct {
+	// ActionCount is the number of actions taken so far.
+	ActionCount int64 `json:"actionCount"`
+
+	// MissedCatchupWindow is the number of actions skipped, because they
+	// were missed for longer than the catchup window.
+	MissedCatchupWindow int64 `json:"missedCatchupWindow"`
+
+	// OverlapSkipped is the number of actions skipped by the overlap policy.
+	OverlapSkipped int64 `json:"overlapSkipped"`
+
+	// RunningWorkflows started by the Schedule, which are still running.
+	// +optional
+	RunningWorkflows []ScheduleWorkflowExecution `json:"runningWorkflows,omitempty"`
+
+	// RecentActions taken by the Schedule.
+	// +optional
+	RecentActions []ScheduleActionResult `json:"recentActions,omitempty"`
+
+	// NextActionTimes are the next times at which actions will be taken.
+	// +optional
+	NextActionTimes []metav1.Time `json:"nextActionTimes,omitempty"`
+
+	// +optional
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// +optional
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// ScheduleWorkflowExecution identifies a workflow started by a Schedule.
+type ScheduleWorkflowExecution struct {
+	WorkflowId string `json:"workflowId"`
+
+	RunId string `json:"runId"`
+}
+
+// ScheduleActionResult describes an action taken by a Schedule.
+type ScheduleActionResult struct {
+	// ScheduleTime at which the action was due, including jitter.
+	// +optional
+	ScheduleTime *metav1.Time `json:"scheduleTime,omitempty"`
+
+	// ActualTime at which the action was taken.
+	// +optional
+	ActualTime *metav1.Time `json:"actualTime,omitempty"`
+
+	// StartedWorkflow by the action.
+	// +optional
+	StartedWorkflow *ScheduleWorkflowExecution `json:"startedWorkflow,omitempty"`
+}
+
+// A ScheduleResourceSpec defines the desired state of a Schedule.
+type ScheduleResourceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ScheduleParameters `json:"forProvider"`
+}
+
+// A ScheduleStatus represents the observed state of a Schedule.
+type ScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Schedule takes an action at times described by its spec.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PAUSED",type="boolean",JSONPath=".status.atProvider.state.paused"
+// +kubebuilder:printcolumn:name="NEXT-ACTION",type="date",JSONPath=".status.atProvider.info.nextActionTimes[0]"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+type Schedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScheduleResourceSpec `json:"spec"`
+	Status ScheduleStatus       `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScheduleList contains a list of Schedule
+type ScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Schedule `json:"items"`
+}
+
+// AnnotationKeyTriggerNow triggers the action of a Schedule immediately. The
+// value is the overlap policy of the triggered action, i.e. "AllowAll", or
+// "true" to use the overlap policy of the Schedule. The annotation is removed
+// after the Schedule has been triggered.
+const AnnotationKeyTriggerNow = "temporal.crossplane.io/trigger-now"
+
+// Schedule type metadata.
+var (
+	ScheduleKind             = reflect.TypeOf(Schedule{}).Name()
+	ScheduleGroupKind        = schema.GroupKind{Group: Group, Kind: ScheduleKind}.String()
+	ScheduleKindAPIVersion   = ScheduleKind + "." + SchemeGroupVersion.String()
+	ScheduleGroupVersionKind = SchemeGroupVersion.WithKind(ScheduleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Schedule{}, &ScheduleList{})
+}
@@ -28,18 +28,32 @@ import (
 
 // SearchAttributeParameters are the configurable fields of a SearchAttribute.
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.temporalNamespaceName) || has(self.temporalNamespaceName)", message="TemporalNamespaceName is required once set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.name) || has(self.name)",message="Name is required once set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.name) || !has(self.name) || self.name == oldSelf.name || (has(self.allowRename) && self.allowRename)",message="Name is immutable, unless allowRename is true"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.type) || has(self.type)",message="Type is required once set"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.type) || !has(self.type) || self.type == oldSelf.type || (has(self.allowRecreate) && self.allowRecreate)",message="Type is immutable, unless allowRecreate is true"
+// +kubebuilder:validation:XValidation:rule="!(has(self.temporalNamespaceNames) || has(self.temporalNamespaceNamesRefs) || has(self.temporalNamespaceNamesSelector)) || !(has(self.temporalNamespaceName) || has(self.temporalNamespaceNameRef) || has(self.temporalNamespaceNameSelector))",message="temporalNamespaceNames can not be combined with temporalNamespaceName"
+// +kubebuilder:validation:XValidation:rule="!(has(self.temporalNamespaceNames) || has(self.temporalNamespaceNamesRefs) || has(self.temporalNamespaceNamesSelector)) || (has(self.name) && has(self.type) && !(has(self.allowRename) && self.allowRename) && !(has(self.allowRecreate) && self.allowRecreate))",message="A SearchAttribute in multiple namespaces requires name and type and does not support allowRename and allowRecreate"
 type SearchAttributeParameters struct {
 
-	// Name of the SearchAttribute (immutable)
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable"
-	Name string `json:"name"`
-
-	// Type of the SearchAttribute (immutable)
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Type is immutable"
+	// Name of the SearchAttribute (immutable, unless allowRename is true)
+	// If not set, it is initialized with the external name
+	// <namespace>.<name>, which allows to adopt an existing SearchAttribute.
+	// The names of the system and predefined search attributes of Temporal
+	// and names starting with Temporal are reserved.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_-]*$`
+	// +kubebuilder:validation:XValidation:rule="!(self in ['WorkflowId', 'RunId', 'WorkflowType', 'StartTime', 'ExecutionTime', 'CloseTime', 'ExecutionStatus', 'TaskQueue', 'HistoryLength', 'HistorySizeBytes', 'ExecutionDuration', 'StateTransitionCount', 'ParentWorkflowId', 'ParentRunId', 'RootWorkflowId', 'RootRunId', 'NamespaceId', 'Memo', 'Encoding', 'VisibilityTaskKey', 'BatcherUser', 'BatcherNamespace', 'BinaryChecksums', 'BuildIds'])",message="Name is reserved by Temporal"
+	// +kubebuilder:validation:XValidation:rule="!self.startsWith('Temporal')",message="Names starting with Temporal are reserved"
+	Name string `json:"name,omitempty"`
+
+	// Type of the SearchAttribute (immutable, unless allowRecreate is true)
+	// Required to create a SearchAttribute. If not set, it is initialized
+	// with the type of an adopted SearchAttribute.
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=Text;Keyword;Int;Double;Bool;Datetime;KeywordList;
-	Type string `json:"type"`
+	Type string `json:"type,omitempty"`
 
 	// Namespace where search-attribute will be created (immutable)
 	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
@@ -57,6 +71,37 @@ type SearchAttributeParameters struct {
 	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
 	// +optional
 	TemporalNamespaceNameSelector *xpv1.Selector `json:"temporalNamespaceNameSelector,omitempty"`
+
+	// TemporalNamespaceNames are the namespaces, where the search-attribute
+	// will be created, e.g. for an attribute, which every namespace must have.
+	// Can not be combined with temporalNamespaceName.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/core/v1alpha1.TemporalNamespace
+	TemporalNamespaceNames []string `json:"temporalNamespaceNames,omitempty"`
+
+	// TemporalNamespaceNamesRefs reference TemporalNamespaces and retrieve their names
+	// +optional
+	TemporalNamespaceNamesRefs []xpv1.Reference `json:"temporalNamespaceNamesRefs,omitempty"`
+
+	// TemporalNamespaceNamesSelector selects references to all matching
+	// TemporalNamespaces and retrieves their names
+	// +optional
+	TemporalNamespaceNamesSelector *xpv1.Selector `json:"temporalNamespaceNamesSelector,omitempty"`
+
+	// AllowRecreate allows to change the type of the SearchAttribute by
+	// removing and adding it again, because Temporal does not allow to update
+	// a SearchAttribute. Workflow executions keep the values of the old type,
+	// which might break queries or, with Elasticsearch, adding the new type.
+	// +optional
+	AllowRecreate bool `json:"allowRecreate,omitempty"`
+
+	// AllowRename allows to change the name of the SearchAttribute by
+	// removing the SearchAttribute with the old name and adding it with the
+	// new name. Workflow executions keep their values of the old name, which
+	// are neither searchable by the new name nor migrated.
+	// +optional
+	AllowRename bool `json:"allowRename,omitempty"`
 }
 
 // SearchAttributeObservation are the observable fields of a SearchAttribute.
@@ -66,12 +111,61 @@ type SearchAttributeObservation struct {
 	Type string `json:"type"`
 
 	TemporalNamespaceName string `json:"temporalNamespaceName"`
+
+	// Origin is Custom for a SearchAttribute, which was added to the
+	// namespace, and System for a SearchAttribute predefined by Temporal.
+	// +optional
+	Origin string `json:"origin,omitempty"`
+
+	// VisibilityStore is the kind of visibility store of the Temporal server,
+	// i.e. Elasticsearch or SQL.
+	// +optional
+	VisibilityStore string `json:"visibilityStore,omitempty"`
+
+	// StorageType is the type of the SearchAttribute in the mapping of the
+	// Elasticsearch index. It is only reported by Elasticsearch.
+	// +optional
+	StorageType string `json:"storageType,omitempty"`
+
+	// Namespaces are the observations of a SearchAttribute, which is created
+	// in multiple namespaces.
+	// +optional
+	Namespaces []SearchAttributeNamespaceObservation `json:"namespaces,omitempty"`
+
+	// UnsupportedReason is the error, with which the server rejected the
+	// SearchAttribute, because it is not supported, e.g. by its visibility
+	// store. Creating it is not retried until the generation changes.
+	// +optional
+	UnsupportedReason string `json:"unsupportedReason,omitempty"`
+
+	// UnsupportedGeneration is the generation of the SearchAttribute, which
+	// the server rejected.
+	// +optional
+	UnsupportedGeneration int64 `json:"unsupportedGeneration,omitempty"`
+}
+
+// SearchAttributeNamespaceObservation is the observation of a SearchAttribute
+// in one of multiple namespaces.
+type SearchAttributeNamespaceObservation struct {
+	TemporalNamespaceName string `json:"temporalNamespaceName"`
+
+	// Type of the SearchAttribute in the namespace. Empty, if it does not
+	// exist in the namespace.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// Message explains, why the SearchAttribute is not ready in the namespace.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // A SearchAttributeSpec defines the desired state of a SearchAttribute.
 type SearchAttributeSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
-	// +kubebuilder:default={"name": "default"}
+	// ProviderReference specifies the provider that will be used to create,
+	// observe, update, and delete this managed resource.
+	// Deprecated: Use ProviderConfigReference, i.e. `providerConfigRef`.
+	// A set ProviderReference is moved to ProviderConfigReference.
 	ProviderReference *v1.Reference             `json:"providerRef,omitempty"`
 	ForProvider       SearchAttributeParameters `json:"forProvider"`
 }
@@ -80,6 +174,12 @@ type SearchAttributeSpec struct {
 type SearchAttributeStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          SearchAttributeObservation `json:"atProvider,omitempty"`
+
+	// ManagedTemporalNamespaceNames are the namespaces of a SearchAttribute in
+	// multiple namespaces, where it was created. It is removed from namespaces,
+	// which are no longer part of the spec.
+	// +optional
+	ManagedTemporalNamespaceNames []string `json:"managedTemporalNamespaceNames,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -88,9 +188,11 @@ type SearchAttributeStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="NAMESPACE",type="string",JSONPath=".spec.forProvider.temporalNamespaceName"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal},shortName=tsa
 type SearchAttribute struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
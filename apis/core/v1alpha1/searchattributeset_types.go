@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SearchAttributeSetParameters are the configurable fields of a SearchAttributeSet.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.temporalNamespaceName) || has(self.temporalNamespaceName)", message="TemporalNamespaceName is required once set"
+type SearchAttributeSetParameters struct {
+
+	// SearchAttributes maps the name of each SearchAttribute to its type.
+	// The type of a SearchAttribute is immutable. The names of the system and
+	// predefined search attributes of Temporal and names starting with
+	// Temporal are reserved.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxProperties=100
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] in ['Text', 'Keyword', 'Int', 'Double', 'Bool', 'Datetime', 'KeywordList'])",message="Type must be one of Text, Keyword, Int, Double, Bool, Datetime or KeywordList"
+	// +kubebuilder:validation:XValidation:rule="oldSelf.all(k, !(k in self) || self[k] == oldSelf[k])",message="Type is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.all(k, !(k in ['WorkflowId', 'RunId', 'WorkflowType', 'StartTime', 'ExecutionTime', 'CloseTime', 'ExecutionStatus', 'TaskQueue', 'HistoryLength', 'HistorySizeBytes', 'ExecutionDuration', 'StateTransitionCount', 'ParentWorkflowId', 'ParentRunId', 'RootWorkflowId', 'RootRunId', 'NamespaceId', 'Memo', 'Encoding', 'VisibilityTaskKey', 'BatcherUser', 'BatcherNamespace', 'BinaryChecksums', 'BuildIds']) && !k.startsWith('Temporal'))",message="Names of the system and predefined search attributes and names starting with Temporal are reserved"
+	SearchAttributes map[string]string `json:"searchAttributes"`
+
+	// Namespace where the search-attributes will be created (immutable)
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="TemporalNamespaceName is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/core/v1alpha1.TemporalNamespace
+	TemporalNamespaceName *string `json:"temporalNamespaceName,omitempty"`
+
+	// Namespace reference to retrieve the namespace name, where the search-attributes will be created
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameRef *xpv1.Reference `json:"temporalNamespaceNameRef,omitempty"`
+
+	// TemporalNamespaceNameSelector selects a reference to a TemporalNamespace and retrieves its name
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameSelector *xpv1.Selector `json:"temporalNamespaceNameSelector,omitempty"`
+}
+
+// SearchAttributeSetObservation are the observable fields of a SearchAttributeSet.
+type SearchAttributeSetObservation struct {
+	TemporalNamespaceName string `json:"temporalNamespaceName"`
+
+	// SearchAttributes maps the name of each SearchAttribute of the set, which
+	// exists in the namespace, to its type.
+	// +optional
+	SearchAttributes map[string]string `json:"searchAttributes,omitempty"`
+}
+
+// A SearchAttributeSetSpec defines the desired state of a SearchAttributeSet.
+type SearchAttributeSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SearchAttributeSetParameters `json:"forProvider"`
+}
+
+// A SearchAttributeSetStatus represents the observed state of a SearchAttributeSet.
+type SearchAttributeSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SearchAttributeSetObservation `json:"atProvider,omitempty"`
+
+	// ManagedSearchAttributes are the names of the SearchAttributes, which
+	// were reconciled from the spec. SearchAttributes removed from the spec
+	// are removed from the namespace.
+	// +optional
+	ManagedSearchAttributes []string `json:"managedSearchAttributes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SearchAttributeSet manages multiple SearchAttributes of one namespace.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+type SearchAttributeSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SearchAttributeSetSpec   `json:"spec"`
+	Status SearchAttributeSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SearchAttributeSetList contains a list of SearchAttributeSet
+type SearchAttributeSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SearchAttributeSet `json:"items"`
+}
+
+// SearchAttributeSet type metadata.
+var (
+	SearchAttributeSetKind             = reflect.TypeOf(SearchAttributeSet{}).Name()
+	SearchAttributeSetGroupKind        = schema.GroupKind{Group: Group, Kind: SearchAttributeSetKind}.String()
+	SearchAttributeSetKindAPIVersion   = SearchAttributeSetKind + "." + SchemeGroupVersion.String()
+	SearchAttributeSetGroupVersionKind = SchemeGroupVersion.WithKind(SearchAttributeSetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SearchAttributeSet{}, &SearchAttributeSetList{})
+}
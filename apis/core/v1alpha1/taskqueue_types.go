@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TaskQueueParameters are the configurable fields of a TaskQueue.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.temporalNamespaceName) || has(self.temporalNamespaceName)", message="TemporalNamespaceName is required once set"
+type TaskQueueParameters struct {
+
+	// Namespace of the task queue (immutable)
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="TemporalNamespaceName is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/core/v1alpha1.TemporalNamespace
+	TemporalNamespaceName *string `json:"temporalNamespaceName,omitempty"`
+
+	// Namespace reference to retrieve the namespace name of the task queue
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameRef *xpv1.Reference `json:"temporalNamespaceNameRef,omitempty"`
+
+	// TemporalNamespaceNameSelector selects a reference to a TemporalNamespace and retrieves its name
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameSelector *xpv1.Selector `json:"temporalNamespaceNameSelector,omitempty"`
+
+	// TaskQueue which is observed (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="TaskQueue is immutable"
+	TaskQueue string `json:"taskQueue"`
+}
+
+// TaskQueueObservation are the observable fields of a TaskQueue.
+type TaskQueueObservation struct {
+	TemporalNamespaceName string `json:"temporalNamespaceName"`
+
+	TaskQueue string `json:"taskQueue"`
+
+	// Workflow describes the workflow tasks of the task queue.
+	// +optional
+	Workflow *TaskQueueTypeInfo `json:"workflow,omitempty"`
+
+	// Activity describes the activity tasks of the task queue.
+	// +optional
+	Activity *TaskQueueTypeInfo `json:"activity,omitempty"`
+}
+
+// TaskQueueTypeInfo describes the pollers and the backlog of one type of
+// tasks of a task queue.
+type TaskQueueTypeInfo struct {
+	// Pollers which polled the task queue recently.
+	// +optional
+	Pollers []TaskQueuePoller `json:"pollers,omitempty"`
+
+	// BacklogCountHint is the approximate number of tasks in the backlog.
+	BacklogCountHint int64 `json:"backlogCountHint"`
+
+	// ReadLevel is the id of the last task read from the backlog.
+	ReadLevel int64 `json:"readLevel"`
+
+	// AckLevel is the id of the last task completed.
+	AckLevel int64 `json:"ackLevel"`
+
+	// RatePerSecond at which tasks are dispatched, formatted as decimal.
+	// +optional
+	RatePerSecond string `json:"ratePerSecond,omitempty"`
+}
+
+// TaskQueuePoller describes a worker polling a task queue.
+type TaskQueuePoller struct {
+	Identity string `json:"identity"`
+
+	// +optional
+	LastAccessTime *metav1.Time `json:"lastAccessTime,omitempty"`
+
+	// RatePerSecond at which the poller polls, formatted as decimal.
+	// +optional
+	RatePerSecond string `json:"ratePerSecond,omitempty"`
+
+	// BuildId of the worker, if it uses Worker Versioning.
+	// +optional
+	BuildId string `json:"buildId,omitempty"`
+
+	// UseVersioning is true, if the worker opted into Worker Versioning.
+	// +optional
+	UseVersioning bool `json:"useVersioning,omitempty"`
+}
+
+// A TaskQueueSpec defines the desired state of a TaskQueue.
+type TaskQueueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaskQueueParameters `json:"forProvider"`
+}
+
+// A TaskQueueStatus represents the observed state of a TaskQueue.
+type TaskQueueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaskQueueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TaskQueue observes the pollers and the backlog of a task queue. Task queues
+// are created implicitly by Temporal, therefore a TaskQueue is observe only.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="WORKFLOW-BACKLOG",type="integer",JSONPath=".status.atProvider.workflow.backlogCountHint"
+// +kubebuilder:printcolumn:name="ACTIVITY-BACKLOG",type="integer",JSONPath=".status.atProvider.activity.backlogCountHint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+type TaskQueue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaskQueueSpec   `json:"spec"`
+	Status TaskQueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaskQueueList contains a list of TaskQueue
+type TaskQueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TaskQueue `json:"items"`
+}
+
+// TaskQueue type metadata.
+var (
+	TaskQueueKind             = reflect.TypeOf(TaskQueue{}).Name()
+	TaskQueueGroupKind        = schema.GroupKind{Group: Group, Kind: TaskQueueKind}.String()
+	TaskQueueKindAPIVersion   = TaskQueueKind + "." + SchemeGroupVersion.String()
+	TaskQueueGroupVersionKind = SchemeGroupVersion.WithKind(TaskQueueKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TaskQueue{}, &TaskQueueList{})
+}
@@ -24,43 +24,159 @@ import (
 
 	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
 )
 
 // TemporalNamespaceParameters are the configurable fields of a TemporalNamespace.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.name) || has(self.name)",message="Name is required once set"
+// +kubebuilder:validation:XValidation:rule="!has(self.historyArchivalState) || self.historyArchivalState != 'Enabled' || (has(self.historyArchivalUri) && size(self.historyArchivalUri) > 0)",message="HistoryArchivalUri is required, if historyArchivalState is Enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.visibilityArchivalState) || self.visibilityArchivalState != 'Enabled' || (has(self.visibilityArchivalUri) && size(self.visibilityArchivalUri) > 0)",message="VisibilityArchivalUri is required, if visibilityArchivalState is Enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.workflowExecutionRetention) || !has(self.workflowExecutionRetentionDays)",message="Only one of workflowExecutionRetention or workflowExecutionRetentionDays can be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.activeClusterName) || !has(self.clusters) || self.clusters.exists(c, c == self.activeClusterName)",message="ActiveClusterName must be one of the clusters"
+// +kubebuilder:validation:XValidation:rule="!has(self.dataFrom) || !has(self.data) || self.dataFrom.all(d, !(d.key in self.data))",message="Keys of dataFrom must not be set in data"
 type TemporalNamespaceParameters struct {
 
 	// Name of the Namespace (immutable)
-	// +kubebuilder:validation:Required
+	// If not set, it is initialized with the external name, which allows to
+	// adopt an existing Namespace. The name temporal-system is reserved by
+	// Temporal.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=1000
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Name is immutable"
-	Name string `json:"name"`
+	// +kubebuilder:validation:XValidation:rule="self != 'temporal-system'",message="Name temporal-system is reserved"
+	Name string `json:"name,omitempty"`
+
+	// AdoptionPolicy defines whether an existing Namespace with the same name
+	// is adopted. Strict refuses to adopt it and reports the condition reason
+	// AlreadyExists. Namespaces can always be adopted by their external name.
+	// +kubebuilder:default=Adopt
+	// +kubebuilder:validation:Enum=Adopt;Strict
+	AdoptionPolicy string `json:"adoptionPolicy,omitempty"`
 
 	// +optional
 	Description *string `json:"description,omitempty"`
 
+	// Email address of the owner of the Namespace
 	// +optional
+	// +kubebuilder:validation:Pattern=`^$|^[^@\s]+@[^@\s]+\.[^@\s]+$`
 	OwnerEmail *string `json:"ownerEmail,omitempty"`
 
-	// Workflow Execution retention.
-	// +kubebuilder:default=30
+	// Workflow Execution retention in days.
+	// If neither workflowExecutionRetentionDays nor workflowExecutionRetention
+	// is set, the retention is 30 days.
+	// +optional
 	// +kubebuilder:validation:Minimum=1
 	WorkflowExecutionRetentionDays int `json:"workflowExecutionRetentionDays,omitempty"`
 
+	// Workflow Execution retention as duration, e.g. "36h".
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="WorkflowExecutionRetention must be positive"
+	WorkflowExecutionRetention *metav1.Duration `json:"workflowExecutionRetention,omitempty"`
+
 	// +optional
 	Data *map[string]string `json:"data,omitempty"`
 
+	// DataFrom sources entries of the Namespace data from keys of ConfigMaps
+	// or Secrets. Changes of the ConfigMaps or Secrets are applied on the
+	// next poll.
+	// +optional
+	// +listType=map
+	// +listMapKey=key
+	// +kubebuilder:validation:MaxItems=64
+	DataFrom []NamespaceDataFrom `json:"dataFrom,omitempty"`
+
+	// DataMergeStrategy defines how data is reconciled. Replace reconciles
+	// all keys of the Namespace data and clears the keys, which are not in
+	// the spec. Merge only reconciles the keys, which are managed by the
+	// spec, and keeps keys written by others.
+	// +kubebuilder:default=Replace
+	// +kubebuilder:validation:Enum=Replace;Merge
+	DataMergeStrategy string `json:"dataMergeStrategy,omitempty"`
+
 	// +kubebuilder:default=Disabled
 	// +kubebuilder:validation:Enum=Disabled;Enabled
 	HistoryArchivalState string `json:"historyArchivalState,omitempty"`
 
+	// URI of the history archival. Required, if historyArchivalState is
+	// Enabled. Temporal does not allow to change it once set. Supported
+	// schemes are file, gs and s3.
 	// +optional
+	// +kubebuilder:validation:Pattern=`^$|^(file|gs|s3)://.+$`
 	HistoryArchivalUri *string `json:"historyArchivalUri,omitempty"`
 
 	// +kubebuilder:default=Disabled
 	// +kubebuilder:validation:Enum=Disabled;Enabled
 	VisibilityArchivalState string `json:"visibilityArchivalState,omitempty"`
 
+	// URI of the visibility archival. Required, if visibilityArchivalState is
+	// Enabled. Temporal does not allow to change it once set. Supported
+	// schemes are file, gs and s3.
 	// +optional
+	// +kubebuilder:validation:Pattern=`^$|^(file|gs|s3)://.+$`
 	VisibilityArchivalUri *string `json:"visibilityArchivalUri,omitempty"`
+
+	// CustomSearchAttributeAliases maps the database fields of custom search
+	// attributes, e.g. "Keyword01", to their alias in the Namespace. Only used
+	// by SQL visibility stores. If not set, the aliases are not managed.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(k, size(self[k]) > 0)",message="Aliases must not be empty"
+	CustomSearchAttributeAliases *map[string]string `json:"customSearchAttributeAliases,omitempty"`
+
+	// PublishTLSConnectionDetails additionally publishes the CA certificate,
+	// client certificate and client key of the ProviderConfig as connection
+	// details. Only enable it, if the consumers of the connection details are
+	// allowed to use the client certificate of the provider.
+	// +optional
+	PublishTLSConnectionDetails bool `json:"publishTLSConnectionDetails,omitempty"`
+
+	// IsGlobalNamespace registers the Namespace as global namespace, which
+	// is replicated to multiple clusters (immutable)
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="IsGlobalNamespace is immutable"
+	IsGlobalNamespace bool `json:"isGlobalNamespace,omitempty"`
+
+	// Clusters to which the Namespace is replicated.
+	// If not set, the clusters are not managed.
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+
+	// ActiveClusterName is the cluster, which is active for the Namespace.
+	// If not set, the active cluster is not managed. Leave it unset, if the
+	// Namespace is failed over by a NamespaceFailover.
+	// +optional
+	ActiveClusterName *string `json:"activeClusterName,omitempty"`
+}
+
+// NamespaceDataFrom sources an entry of the Namespace data from a key of a
+// ConfigMap or a Secret.
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.secretKeyRef)",message="Exactly one of configMapKeyRef or secretKeyRef is required"
+type NamespaceDataFrom struct {
+	// Key of the entry in the Namespace data
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// ConfigMapKeyRef references a key of a ConfigMap, whose value is used
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef references a key of a Secret, whose value is used
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// ConfigMapKeySelector is a reference to a key of a ConfigMap in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap
+	Namespace string `json:"namespace"`
+
+	// Key of the ConfigMap
+	Key string `json:"key"`
 }
 
 // TemporalNamespaceObservation are the observable fields of a TemporalNamespace.
@@ -73,8 +189,12 @@ type TemporalNamespaceObservation struct {
 
 	OwnerEmail *string `json:"ownerEmail,omitempty"`
 
+	// WorkflowExecutionRetentionDays is the retention rounded down to whole
+	// days. The exact retention is WorkflowExecutionRetention.
 	WorkflowExecutionRetentionDays int `json:"workflowExecutionRetentionDays,omitempty"`
 
+	WorkflowExecutionRetention *metav1.Duration `json:"workflowExecutionRetention,omitempty"`
+
 	Data *map[string]string `json:"data,omitempty"`
 
 	HistoryArchivalState string `json:"historyArchivalState,omitempty"`
@@ -85,13 +205,30 @@ type TemporalNamespaceObservation struct {
 
 	VisibilityArchivalUri *string `json:"visibilityArchivalUri,omitempty"`
 
+	CustomSearchAttributeAliases *map[string]string `json:"customSearchAttributeAliases,omitempty"`
+
+	IsGlobalNamespace bool `json:"isGlobalNamespace,omitempty"`
+
+	Clusters []string `json:"clusters,omitempty"`
+
+	ActiveClusterName *string `json:"activeClusterName,omitempty"`
+
+	// FailoverVersion of the Namespace, which increases with every failover.
+	FailoverVersion int64 `json:"failoverVersion,omitempty"`
+
+	// ReplicationState of the Namespace, e.g. Normal or Handover.
+	ReplicationState string `json:"replicationState,omitempty"`
+
 	State string `json:"state"`
 }
 
 // A TemporalNamespaceSpec defines the desired state of a TemporalNamespace.
 type TemporalNamespaceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
-	// +kubebuilder:default={"name": "default"}
+	// ProviderReference specifies the provider that will be used to create,
+	// observe, update, and delete this managed resource.
+	// Deprecated: Use ProviderConfigReference, i.e. `providerConfigRef`.
+	// A set ProviderReference is moved to ProviderConfigReference.
 	ProviderReference *v1.Reference               `json:"providerRef,omitempty"`
 	ForProvider       TemporalNamespaceParameters `json:"forProvider"`
 }
@@ -100,6 +237,23 @@ type TemporalNamespaceSpec struct {
 type TemporalNamespaceStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          TemporalNamespaceObservation `json:"atProvider,omitempty"`
+
+	// ManagedDataKeys are the keys of the Namespace data, which were
+	// reconciled from the spec. With dataMergeStrategy Merge, keys removed
+	// from the spec are cleared in the Namespace data.
+	// +optional
+	ManagedDataKeys []string `json:"managedDataKeys,omitempty"`
+
+	// RejectedWorkflowExecutionRetention is the retention of the spec, which
+	// was rejected by the server. It is not applied again until the retention
+	// of the spec changes.
+	// +optional
+	RejectedWorkflowExecutionRetention *metav1.Duration `json:"rejectedWorkflowExecutionRetention,omitempty"`
+
+	// AsyncOperation tracks the deletion of the Namespace, which Temporal
+	// applies asynchronously by reclaiming its resources.
+	// +optional
+	AsyncOperation apisv1alpha1.AsyncOperationStatus `json:"asyncOperation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -108,9 +262,11 @@ type TemporalNamespaceStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="RETENTION",type="string",JSONPath=".status.atProvider.workflowExecutionRetention"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal},shortName=tns
 type TemporalNamespace struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -128,6 +284,18 @@ type TemporalNamespaceList struct {
 	Items           []TemporalNamespace `json:"items"`
 }
 
+// Strategies how the data of a TemporalNamespace is reconciled.
+const (
+	DataMergeStrategyReplace = "Replace"
+	DataMergeStrategyMerge   = "Merge"
+)
+
+// Policies whether an existing namespace is adopted by a TemporalNamespace.
+const (
+	AdoptionPolicyAdopt  = "Adopt"
+	AdoptionPolicyStrict = "Strict"
+)
+
 // TemporalNamespace type metadata.
 var (
 	TemporalNamespaceKind             = reflect.TypeOf(TemporalNamespace{}).Name()
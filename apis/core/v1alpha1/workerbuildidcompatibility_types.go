@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WorkerBuildIdCompatibilityParameters are the configurable fields of a WorkerBuildIdCompatibility.
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.temporalNamespaceName) || has(self.temporalNamespaceName)", message="TemporalNamespaceName is required once set"
+type WorkerBuildIdCompatibilityParameters struct {
+
+	// Namespace of the task queue (immutable)
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="TemporalNamespaceName is immutable"
+	// +crossplane:generate:reference:type=github.com/denniskniep/provider-temporal/apis/core/v1alpha1.TemporalNamespace
+	TemporalNamespaceName *string `json:"temporalNamespaceName,omitempty"`
+
+	// Namespace reference to retrieve the namespace name of the task queue
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameRef *xpv1.Reference `json:"temporalNamespaceNameRef,omitempty"`
+
+	// TemporalNamespaceNameSelector selects a reference to a TemporalNamespace and retrieves its name
+	// At least one of temporalNamespaceName, temporalNamespaceNameRef or temporalNamespaceNameSelector is required.
+	// +optional
+	TemporalNamespaceNameSelector *xpv1.Selector `json:"temporalNamespaceNameSelector,omitempty"`
+
+	// TaskQueue whose Build ID compatibility is managed (immutable)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="TaskQueue is immutable"
+	TaskQueue string `json:"taskQueue"`
+
+	// VersionSets of compatible Build IDs ordered from oldest to newest.
+	// The last set is the default set of the task queue. Build IDs can not be
+	// removed from a task queue, therefore Build IDs which exist on the server,
+	// but not in any set, are ignored.
+	// +kubebuilder:validation:MinItems=1
+	VersionSets []BuildIdVersionSet `json:"versionSets"`
+}
+
+// BuildIdVersionSet is a set of compatible Build IDs.
+type BuildIdVersionSet struct {
+	// BuildIds ordered from oldest to newest. The last Build ID is the
+	// default of the set.
+	// +kubebuilder:validation:MinItems=1
+	BuildIds []string `json:"buildIds"`
+}
+
+// WorkerBuildIdCompatibilityObservation are the observable fields of a WorkerBuildIdCompatibility.
+type WorkerBuildIdCompatibilityObservation struct {
+	TemporalNamespaceName string `json:"temporalNamespaceName"`
+
+	TaskQueue string `json:"taskQueue"`
+
+	// VersionSets of the task queue as returned by the server.
+	// +optional
+	VersionSets []BuildIdVersionSet `json:"versionSets,omitempty"`
+
+	// DefaultBuildId of the task queue.
+	// +optional
+	DefaultBuildId string `json:"defaultBuildId,omitempty"`
+
+	// Reachability of the Build IDs of the task queue. It is only reported,
+	// if the server enables the Worker Versioning workflow APIs.
+	// +optional
+	Reachability []BuildIdReachability `json:"reachability,omitempty"`
+}
+
+// BuildIdReachability describes whether workers with a Build ID are still
+// required to process tasks.
+type BuildIdReachability struct {
+	BuildId string `json:"buildId"`
+
+	// Reachability summarizes the TaskReachability:
+	// Reachable, if new, existing or open workflows can reach the Build ID.
+	// ClosedOnly, if only closed workflows can reach the Build ID, e.g. by
+	// queries. Unreachable, if workers with the Build ID can be retired.
+	// +kubebuilder:validation:Enum=Reachable;ClosedOnly;Unreachable
+	Reachability string `json:"reachability"`
+
+	// TaskReachability as returned by the server, e.g. NewWorkflows,
+	// ExistingWorkflows, OpenWorkflows or ClosedWorkflows.
+	// +optional
+	TaskReachability []string `json:"taskReachability,omitempty"`
+}
+
+// A WorkerBuildIdCompatibilitySpec defines the desired state of a WorkerBuildIdCompatibility.
+type WorkerBuildIdCompatibilitySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkerBuildIdCompatibilityParameters `json:"forProvider"`
+}
+
+// A WorkerBuildIdCompatibilityStatus represents the observed state of a WorkerBuildIdCompatibility.
+type WorkerBuildIdCompatibilityStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkerBuildIdCompatibilityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkerBuildIdCompatibility manages the compatible Build ID sets of a task queue.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DEFAULT-BUILD-ID",type="string",JSONPath=".status.atProvider.defaultBuildId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,temporal}
+type WorkerBuildIdCompatibility struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkerBuildIdCompatibilitySpec   `json:"spec"`
+	Status WorkerBuildIdCompatibilityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkerBuildIdCompatibilityList contains a list of WorkerBuildIdCompatibility
+type WorkerBuildIdCompatibilityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkerBuildIdCompatibility `json:"items"`
+}
+
+// WorkerBuildIdCompatibility type metadata.
+var (
+	WorkerBuildIdCompatibilityKind             = reflect.TypeOf(WorkerBuildIdCompatibility{}).Name()
+	WorkerBuildIdCompatibilityGroupKind        = schema.GroupKind{Group: Group, Kind: WorkerBuildIdCompatibilityKind}.String()
+	WorkerBuildIdCompatibilityKindAPIVersion   = WorkerBuildIdCompatibilityKind + "." + SchemeGroupVersion.String()
+	WorkerBuildIdCompatibilityGroupVersionKind = SchemeGroupVersion.WithKind(WorkerBuildIdCompatibilityKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WorkerBuildIdCompatibility{}, &WorkerBuildIdCompatibilityList{})
+}
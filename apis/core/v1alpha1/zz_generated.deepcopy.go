@@ -21,10 +21,920 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildIdReachability) DeepCopyInto(out *BuildIdReachability) {
+	*out = *in
+	if in.TaskReachability != nil {
+		in, out := &in.TaskReachability, &out.TaskReachability
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildIdReachability.
+func (in *BuildIdReachability) DeepCopy() *BuildIdReachability {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildIdReachability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildIdVersionSet) DeepCopyInto(out *BuildIdVersionSet) {
+	*out = *in
+	if in.BuildIds != nil {
+		in, out := &in.BuildIds, &out.BuildIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildIdVersionSet.
+func (in *BuildIdVersionSet) DeepCopy() *BuildIdVersionSet {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildIdVersionSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarRange) DeepCopyInto(out *CalendarRange) {
+	*out = *in
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Step != nil {
+		in, out := &in.Step, &out.Step
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarRange.
+func (in *CalendarRange) DeepCopy() *CalendarRange {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalendarSpec) DeepCopyInto(out *CalendarSpec) {
+	*out = *in
+	if in.Second != nil {
+		in, out := &in.Second, &out.Second
+		*out = new(string)
+		**out = **in
+	}
+	if in.Minute != nil {
+		in, out := &in.Minute, &out.Minute
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hour != nil {
+		in, out := &in.Hour, &out.Hour
+		*out = new(string)
+		**out = **in
+	}
+	if in.DayOfMonth != nil {
+		in, out := &in.DayOfMonth, &out.DayOfMonth
+		*out = new(string)
+		**out = **in
+	}
+	if in.Month != nil {
+		in, out := &in.Month, &out.Month
+		*out = new(string)
+		**out = **in
+	}
+	if in.Year != nil {
+		in, out := &in.Year, &out.Year
+		*out = new(string)
+		**out = **in
+	}
+	if in.DayOfWeek != nil {
+		in, out := &in.DayOfWeek, &out.DayOfWeek
+		*out = new(string)
+		**out = **in
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalendarSpec.
+func (in *CalendarSpec) DeepCopy() *CalendarSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CalendarSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntervalSpec) DeepCopyInto(out *IntervalSpec) {
+	*out = *in
+	out.Every = in.Every
+	if in.Phase != nil {
+		in, out := &in.Phase, &out.Phase
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntervalSpec.
+func (in *IntervalSpec) DeepCopy() *IntervalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IntervalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDataFrom) DeepCopyInto(out *NamespaceDataFrom) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDataFrom.
+func (in *NamespaceDataFrom) DeepCopy() *NamespaceDataFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDataFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailover) DeepCopyInto(out *NamespaceFailover) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailover.
+func (in *NamespaceFailover) DeepCopy() *NamespaceFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceFailover) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailoverHistoryEntry) DeepCopyInto(out *NamespaceFailoverHistoryEntry) {
+	*out = *in
+	if in.FailoverTime != nil {
+		in, out := &in.FailoverTime, &out.FailoverTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailoverHistoryEntry.
+func (in *NamespaceFailoverHistoryEntry) DeepCopy() *NamespaceFailoverHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailoverHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailoverList) DeepCopyInto(out *NamespaceFailoverList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespaceFailover, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailoverList.
+func (in *NamespaceFailoverList) DeepCopy() *NamespaceFailoverList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailoverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceFailoverList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailoverObservation) DeepCopyInto(out *NamespaceFailoverObservation) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailoverHistory != nil {
+		in, out := &in.FailoverHistory, &out.FailoverHistory
+		*out = make([]NamespaceFailoverHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailoverObservation.
+func (in *NamespaceFailoverObservation) DeepCopy() *NamespaceFailoverObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailoverObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailoverParameters) DeepCopyInto(out *NamespaceFailoverParameters) {
+	*out = *in
+	if in.TemporalNamespaceName != nil {
+		in, out := &in.TemporalNamespaceName, &out.TemporalNamespaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemporalNamespaceNameRef != nil {
+		in, out := &in.TemporalNamespaceNameRef, &out.TemporalNamespaceNameRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemporalNamespaceNameSelector != nil {
+		in, out := &in.TemporalNamespaceNameSelector, &out.TemporalNamespaceNameSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailoverParameters.
+func (in *NamespaceFailoverParameters) DeepCopy() *NamespaceFailoverParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailoverParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailoverSpec) DeepCopyInto(out *NamespaceFailoverSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailoverSpec.
+func (in *NamespaceFailoverSpec) DeepCopy() *NamespaceFailoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceFailoverStatus) DeepCopyInto(out *NamespaceFailoverStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceFailoverStatus.
+func (in *NamespaceFailoverStatus) DeepCopy() *NamespaceFailoverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceFailoverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteCluster) DeepCopyInto(out *RemoteCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteCluster.
+func (in *RemoteCluster) DeepCopy() *RemoteCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterList) DeepCopyInto(out *RemoteClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RemoteCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterList.
+func (in *RemoteClusterList) DeepCopy() *RemoteClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RemoteClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterObservation) DeepCopyInto(out *RemoteClusterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterObservation.
+func (in *RemoteClusterObservation) DeepCopy() *RemoteClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterParameters) DeepCopyInto(out *RemoteClusterParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterParameters.
+func (in *RemoteClusterParameters) DeepCopy() *RemoteClusterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterSpec) DeepCopyInto(out *RemoteClusterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterSpec.
+func (in *RemoteClusterSpec) DeepCopy() *RemoteClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterStatus) DeepCopyInto(out *RemoteClusterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterStatus.
+func (in *RemoteClusterStatus) DeepCopy() *RemoteClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Schedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleAction) DeepCopyInto(out *ScheduleAction) {
+	*out = *in
+	in.StartWorkflow.DeepCopyInto(&out.StartWorkflow)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleAction.
+func (in *ScheduleAction) DeepCopy() *ScheduleAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleActionResult) DeepCopyInto(out *ScheduleActionResult) {
+	*out = *in
+	if in.ScheduleTime != nil {
+		in, out := &in.ScheduleTime, &out.ScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ActualTime != nil {
+		in, out := &in.ActualTime, &out.ActualTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StartedWorkflow != nil {
+		in, out := &in.StartedWorkflow, &out.StartedWorkflow
+		*out = new(ScheduleWorkflowExecution)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleActionResult.
+func (in *ScheduleActionResult) DeepCopy() *ScheduleActionResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleActionResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleInfo) DeepCopyInto(out *ScheduleInfo) {
+	*out = *in
+	if in.RunningWorkflows != nil {
+		in, out := &in.RunningWorkflows, &out.RunningWorkflows
+		*out = make([]ScheduleWorkflowExecution, len(*in))
+		copy(*out, *in)
+	}
+	if in.RecentActions != nil {
+		in, out := &in.RecentActions, &out.RecentActions
+		*out = make([]ScheduleActionResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextActionTimes != nil {
+		in, out := &in.NextActionTimes, &out.NextActionTimes
+		*out = make([]v1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleInfo.
+func (in *ScheduleInfo) DeepCopy() *ScheduleInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleList) DeepCopyInto(out *ScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Schedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleList.
+func (in *ScheduleList) DeepCopy() *ScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleObservation) DeepCopyInto(out *ScheduleObservation) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Action.DeepCopyInto(&out.Action)
+	in.Policies.DeepCopyInto(&out.Policies)
+	in.State.DeepCopyInto(&out.State)
+	if in.Info != nil {
+		in, out := &in.Info, &out.Info
+		*out = new(ScheduleInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastTriggerTime != nil {
+		in, out := &in.LastTriggerTime, &out.LastTriggerTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AppliedRemainingActions != nil {
+		in, out := &in.AppliedRemainingActions, &out.AppliedRemainingActions
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleObservation.
+func (in *ScheduleObservation) DeepCopy() *ScheduleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleParameters) DeepCopyInto(out *ScheduleParameters) {
+	*out = *in
+	if in.TemporalNamespaceName != nil {
+		in, out := &in.TemporalNamespaceName, &out.TemporalNamespaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemporalNamespaceNameRef != nil {
+		in, out := &in.TemporalNamespaceNameRef, &out.TemporalNamespaceNameRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemporalNamespaceNameSelector != nil {
+		in, out := &in.TemporalNamespaceNameSelector, &out.TemporalNamespaceNameSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Action.DeepCopyInto(&out.Action)
+	in.Policies.DeepCopyInto(&out.Policies)
+	in.State.DeepCopyInto(&out.State)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleParameters.
+func (in *ScheduleParameters) DeepCopy() *ScheduleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulePolicies) DeepCopyInto(out *SchedulePolicies) {
+	*out = *in
+	if in.CatchupWindow != nil {
+		in, out := &in.CatchupWindow, &out.CatchupWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulePolicies.
+func (in *SchedulePolicies) DeepCopy() *SchedulePolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulePolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleResourceSpec) DeepCopyInto(out *ScheduleResourceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleResourceSpec.
+func (in *ScheduleResourceSpec) DeepCopy() *ScheduleResourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleResourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleSearchAttribute) DeepCopyInto(out *ScheduleSearchAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSearchAttribute.
+func (in *ScheduleSearchAttribute) DeepCopy() *ScheduleSearchAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleSearchAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
+	*out = *in
+	if in.Calendars != nil {
+		in, out := &in.Calendars, &out.Calendars
+		*out = make([]CalendarSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StructuredCalendars != nil {
+		in, out := &in.StructuredCalendars, &out.StructuredCalendars
+		*out = make([]StructuredCalendarSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CronExpressions != nil {
+		in, out := &in.CronExpressions, &out.CronExpressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Intervals != nil {
+		in, out := &in.Intervals, &out.Intervals
+		*out = make([]IntervalSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludeCalendars != nil {
+		in, out := &in.ExcludeCalendars, &out.ExcludeCalendars
+		*out = make([]CalendarSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludeStructuredCalendars != nil {
+		in, out := &in.ExcludeStructuredCalendars, &out.ExcludeStructuredCalendars
+		*out = make([]StructuredCalendarSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TimezoneName != nil {
+		in, out := &in.TimezoneName, &out.TimezoneName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
+func (in *ScheduleSpec) DeepCopy() *ScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleStartWorkflowAction) DeepCopyInto(out *ScheduleStartWorkflowAction) {
+	*out = *in
+	if in.WorkflowExecutionTimeout != nil {
+		in, out := &in.WorkflowExecutionTimeout, &out.WorkflowExecutionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WorkflowRunTimeout != nil {
+		in, out := &in.WorkflowRunTimeout, &out.WorkflowRunTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WorkflowTaskTimeout != nil {
+		in, out := &in.WorkflowTaskTimeout, &out.WorkflowTaskTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Memo != nil {
+		in, out := &in.Memo, &out.Memo
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SearchAttributes != nil {
+		in, out := &in.SearchAttributes, &out.SearchAttributes
+		*out = make([]ScheduleSearchAttribute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStartWorkflowAction.
+func (in *ScheduleStartWorkflowAction) DeepCopy() *ScheduleStartWorkflowAction {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleStartWorkflowAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleState) DeepCopyInto(out *ScheduleState) {
+	*out = *in
+	if in.Note != nil {
+		in, out := &in.Note, &out.Note
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleState.
+func (in *ScheduleState) DeepCopy() *ScheduleState {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStatus.
+func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWorkflowExecution) DeepCopyInto(out *ScheduleWorkflowExecution) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWorkflowExecution.
+func (in *ScheduleWorkflowExecution) DeepCopy() *ScheduleWorkflowExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWorkflowExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SearchAttribute) DeepCopyInto(out *SearchAttribute) {
 	*out = *in
@@ -34,18 +944,429 @@ func (in *SearchAttribute) DeepCopyInto(out *SearchAttribute) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttribute.
-func (in *SearchAttribute) DeepCopy() *SearchAttribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttribute.
+func (in *SearchAttribute) DeepCopy() *SearchAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SearchAttribute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeList) DeepCopyInto(out *SearchAttributeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SearchAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeList.
+func (in *SearchAttributeList) DeepCopy() *SearchAttributeList {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SearchAttributeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeNamespaceObservation) DeepCopyInto(out *SearchAttributeNamespaceObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeNamespaceObservation.
+func (in *SearchAttributeNamespaceObservation) DeepCopy() *SearchAttributeNamespaceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeNamespaceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeObservation) DeepCopyInto(out *SearchAttributeObservation) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]SearchAttributeNamespaceObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeObservation.
+func (in *SearchAttributeObservation) DeepCopy() *SearchAttributeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeParameters) DeepCopyInto(out *SearchAttributeParameters) {
+	*out = *in
+	if in.TemporalNamespaceName != nil {
+		in, out := &in.TemporalNamespaceName, &out.TemporalNamespaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemporalNamespaceNameRef != nil {
+		in, out := &in.TemporalNamespaceNameRef, &out.TemporalNamespaceNameRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemporalNamespaceNameSelector != nil {
+		in, out := &in.TemporalNamespaceNameSelector, &out.TemporalNamespaceNameSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemporalNamespaceNames != nil {
+		in, out := &in.TemporalNamespaceNames, &out.TemporalNamespaceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemporalNamespaceNamesRefs != nil {
+		in, out := &in.TemporalNamespaceNamesRefs, &out.TemporalNamespaceNamesRefs
+		*out = make([]commonv1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TemporalNamespaceNamesSelector != nil {
+		in, out := &in.TemporalNamespaceNamesSelector, &out.TemporalNamespaceNamesSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeParameters.
+func (in *SearchAttributeParameters) DeepCopy() *SearchAttributeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeSet) DeepCopyInto(out *SearchAttributeSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSet.
+func (in *SearchAttributeSet) DeepCopy() *SearchAttributeSet {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SearchAttributeSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeSetList) DeepCopyInto(out *SearchAttributeSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SearchAttributeSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSetList.
+func (in *SearchAttributeSetList) DeepCopy() *SearchAttributeSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SearchAttributeSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeSetObservation) DeepCopyInto(out *SearchAttributeSetObservation) {
+	*out = *in
+	if in.SearchAttributes != nil {
+		in, out := &in.SearchAttributes, &out.SearchAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSetObservation.
+func (in *SearchAttributeSetObservation) DeepCopy() *SearchAttributeSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeSetParameters) DeepCopyInto(out *SearchAttributeSetParameters) {
+	*out = *in
+	if in.SearchAttributes != nil {
+		in, out := &in.SearchAttributes, &out.SearchAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TemporalNamespaceName != nil {
+		in, out := &in.TemporalNamespaceName, &out.TemporalNamespaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemporalNamespaceNameRef != nil {
+		in, out := &in.TemporalNamespaceNameRef, &out.TemporalNamespaceNameRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemporalNamespaceNameSelector != nil {
+		in, out := &in.TemporalNamespaceNameSelector, &out.TemporalNamespaceNameSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSetParameters.
+func (in *SearchAttributeSetParameters) DeepCopy() *SearchAttributeSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeSetSpec) DeepCopyInto(out *SearchAttributeSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSetSpec.
+func (in *SearchAttributeSetSpec) DeepCopy() *SearchAttributeSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeSetStatus) DeepCopyInto(out *SearchAttributeSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.ManagedSearchAttributes != nil {
+		in, out := &in.ManagedSearchAttributes, &out.ManagedSearchAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSetStatus.
+func (in *SearchAttributeSetStatus) DeepCopy() *SearchAttributeSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeSpec) DeepCopyInto(out *SearchAttributeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	if in.ProviderReference != nil {
+		in, out := &in.ProviderReference, &out.ProviderReference
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSpec.
+func (in *SearchAttributeSpec) DeepCopy() *SearchAttributeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchAttributeStatus) DeepCopyInto(out *SearchAttributeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.ManagedTemporalNamespaceNames != nil {
+		in, out := &in.ManagedTemporalNamespaceNames, &out.ManagedTemporalNamespaceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeStatus.
+func (in *SearchAttributeStatus) DeepCopy() *SearchAttributeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchAttributeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StructuredCalendarSpec) DeepCopyInto(out *StructuredCalendarSpec) {
+	*out = *in
+	if in.Second != nil {
+		in, out := &in.Second, &out.Second
+		*out = make([]CalendarRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Minute != nil {
+		in, out := &in.Minute, &out.Minute
+		*out = make([]CalendarRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hour != nil {
+		in, out := &in.Hour, &out.Hour
+		*out = make([]CalendarRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DayOfMonth != nil {
+		in, out := &in.DayOfMonth, &out.DayOfMonth
+		*out = make([]CalendarRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Month != nil {
+		in, out := &in.Month, &out.Month
+		*out = make([]CalendarRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Year != nil {
+		in, out := &in.Year, &out.Year
+		*out = make([]CalendarRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DayOfWeek != nil {
+		in, out := &in.DayOfWeek, &out.DayOfWeek
+		*out = make([]CalendarRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StructuredCalendarSpec.
+func (in *StructuredCalendarSpec) DeepCopy() *StructuredCalendarSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StructuredCalendarSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskQueue) DeepCopyInto(out *TaskQueue) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueue.
+func (in *TaskQueue) DeepCopy() *TaskQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchAttribute)
+	out := new(TaskQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SearchAttribute) DeepCopyObject() runtime.Object {
+func (in *TaskQueue) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -53,31 +1374,31 @@ func (in *SearchAttribute) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchAttributeList) DeepCopyInto(out *SearchAttributeList) {
+func (in *TaskQueueList) DeepCopyInto(out *TaskQueueList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SearchAttribute, len(*in))
+		*out = make([]TaskQueue, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeList.
-func (in *SearchAttributeList) DeepCopy() *SearchAttributeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueueList.
+func (in *TaskQueueList) DeepCopy() *TaskQueueList {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchAttributeList)
+	out := new(TaskQueueList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SearchAttributeList) DeepCopyObject() runtime.Object {
+func (in *TaskQueueList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -85,22 +1406,32 @@ func (in *SearchAttributeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchAttributeObservation) DeepCopyInto(out *SearchAttributeObservation) {
+func (in *TaskQueueObservation) DeepCopyInto(out *TaskQueueObservation) {
 	*out = *in
+	if in.Workflow != nil {
+		in, out := &in.Workflow, &out.Workflow
+		*out = new(TaskQueueTypeInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Activity != nil {
+		in, out := &in.Activity, &out.Activity
+		*out = new(TaskQueueTypeInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeObservation.
-func (in *SearchAttributeObservation) DeepCopy() *SearchAttributeObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueueObservation.
+func (in *TaskQueueObservation) DeepCopy() *TaskQueueObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchAttributeObservation)
+	out := new(TaskQueueObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchAttributeParameters) DeepCopyInto(out *SearchAttributeParameters) {
+func (in *TaskQueueParameters) DeepCopyInto(out *TaskQueueParameters) {
 	*out = *in
 	if in.TemporalNamespaceName != nil {
 		in, out := &in.TemporalNamespaceName, &out.TemporalNamespaceName
@@ -109,61 +1440,97 @@ func (in *SearchAttributeParameters) DeepCopyInto(out *SearchAttributeParameters
 	}
 	if in.TemporalNamespaceNameRef != nil {
 		in, out := &in.TemporalNamespaceNameRef, &out.TemporalNamespaceNameRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.TemporalNamespaceNameSelector != nil {
 		in, out := &in.TemporalNamespaceNameSelector, &out.TemporalNamespaceNameSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeParameters.
-func (in *SearchAttributeParameters) DeepCopy() *SearchAttributeParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueueParameters.
+func (in *TaskQueueParameters) DeepCopy() *TaskQueueParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchAttributeParameters)
+	out := new(TaskQueueParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchAttributeSpec) DeepCopyInto(out *SearchAttributeSpec) {
+func (in *TaskQueuePoller) DeepCopyInto(out *TaskQueuePoller) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	if in.ProviderReference != nil {
-		in, out := &in.ProviderReference, &out.ProviderReference
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.LastAccessTime != nil {
+		in, out := &in.LastAccessTime, &out.LastAccessTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueuePoller.
+func (in *TaskQueuePoller) DeepCopy() *TaskQueuePoller {
+	if in == nil {
+		return nil
 	}
+	out := new(TaskQueuePoller)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskQueueSpec) DeepCopyInto(out *TaskQueueSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeSpec.
-func (in *SearchAttributeSpec) DeepCopy() *SearchAttributeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueueSpec.
+func (in *TaskQueueSpec) DeepCopy() *TaskQueueSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchAttributeSpec)
+	out := new(TaskQueueSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchAttributeStatus) DeepCopyInto(out *SearchAttributeStatus) {
+func (in *TaskQueueStatus) DeepCopyInto(out *TaskQueueStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchAttributeStatus.
-func (in *SearchAttributeStatus) DeepCopy() *SearchAttributeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueueStatus.
+func (in *TaskQueueStatus) DeepCopy() *TaskQueueStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchAttributeStatus)
+	out := new(TaskQueueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskQueueTypeInfo) DeepCopyInto(out *TaskQueueTypeInfo) {
+	*out = *in
+	if in.Pollers != nil {
+		in, out := &in.Pollers, &out.Pollers
+		*out = make([]TaskQueuePoller, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskQueueTypeInfo.
+func (in *TaskQueueTypeInfo) DeepCopy() *TaskQueueTypeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskQueueTypeInfo)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -240,6 +1607,11 @@ func (in *TemporalNamespaceObservation) DeepCopyInto(out *TemporalNamespaceObser
 		*out = new(string)
 		**out = **in
 	}
+	if in.WorkflowExecutionRetention != nil {
+		in, out := &in.WorkflowExecutionRetention, &out.WorkflowExecutionRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
 		*out = new(map[string]string)
@@ -261,6 +1633,27 @@ func (in *TemporalNamespaceObservation) DeepCopyInto(out *TemporalNamespaceObser
 		*out = new(string)
 		**out = **in
 	}
+	if in.CustomSearchAttributeAliases != nil {
+		in, out := &in.CustomSearchAttributeAliases, &out.CustomSearchAttributeAliases
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveClusterName != nil {
+		in, out := &in.ActiveClusterName, &out.ActiveClusterName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemporalNamespaceObservation.
@@ -286,6 +1679,11 @@ func (in *TemporalNamespaceParameters) DeepCopyInto(out *TemporalNamespaceParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.WorkflowExecutionRetention != nil {
+		in, out := &in.WorkflowExecutionRetention, &out.WorkflowExecutionRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Data != nil {
 		in, out := &in.Data, &out.Data
 		*out = new(map[string]string)
@@ -297,6 +1695,13 @@ func (in *TemporalNamespaceParameters) DeepCopyInto(out *TemporalNamespaceParame
 			}
 		}
 	}
+	if in.DataFrom != nil {
+		in, out := &in.DataFrom, &out.DataFrom
+		*out = make([]NamespaceDataFrom, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.HistoryArchivalUri != nil {
 		in, out := &in.HistoryArchivalUri, &out.HistoryArchivalUri
 		*out = new(string)
@@ -307,6 +1712,27 @@ func (in *TemporalNamespaceParameters) DeepCopyInto(out *TemporalNamespaceParame
 		*out = new(string)
 		**out = **in
 	}
+	if in.CustomSearchAttributeAliases != nil {
+		in, out := &in.CustomSearchAttributeAliases, &out.CustomSearchAttributeAliases
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveClusterName != nil {
+		in, out := &in.ActiveClusterName, &out.ActiveClusterName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemporalNamespaceParameters.
@@ -325,7 +1751,7 @@ func (in *TemporalNamespaceSpec) DeepCopyInto(out *TemporalNamespaceSpec) {
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	if in.ProviderReference != nil {
 		in, out := &in.ProviderReference, &out.ProviderReference
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
@@ -346,6 +1772,17 @@ func (in *TemporalNamespaceStatus) DeepCopyInto(out *TemporalNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.ManagedDataKeys != nil {
+		in, out := &in.ManagedDataKeys, &out.ManagedDataKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RejectedWorkflowExecutionRetention != nil {
+		in, out := &in.RejectedWorkflowExecutionRetention, &out.RejectedWorkflowExecutionRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	out.AsyncOperation = in.AsyncOperation
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemporalNamespaceStatus.
@@ -357,3 +1794,162 @@ func (in *TemporalNamespaceStatus) DeepCopy() *TemporalNamespaceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerBuildIdCompatibility) DeepCopyInto(out *WorkerBuildIdCompatibility) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerBuildIdCompatibility.
+func (in *WorkerBuildIdCompatibility) DeepCopy() *WorkerBuildIdCompatibility {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerBuildIdCompatibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkerBuildIdCompatibility) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerBuildIdCompatibilityList) DeepCopyInto(out *WorkerBuildIdCompatibilityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkerBuildIdCompatibility, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerBuildIdCompatibilityList.
+func (in *WorkerBuildIdCompatibilityList) DeepCopy() *WorkerBuildIdCompatibilityList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerBuildIdCompatibilityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkerBuildIdCompatibilityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerBuildIdCompatibilityObservation) DeepCopyInto(out *WorkerBuildIdCompatibilityObservation) {
+	*out = *in
+	if in.VersionSets != nil {
+		in, out := &in.VersionSets, &out.VersionSets
+		*out = make([]BuildIdVersionSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Reachability != nil {
+		in, out := &in.Reachability, &out.Reachability
+		*out = make([]BuildIdReachability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerBuildIdCompatibilityObservation.
+func (in *WorkerBuildIdCompatibilityObservation) DeepCopy() *WorkerBuildIdCompatibilityObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerBuildIdCompatibilityObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerBuildIdCompatibilityParameters) DeepCopyInto(out *WorkerBuildIdCompatibilityParameters) {
+	*out = *in
+	if in.TemporalNamespaceName != nil {
+		in, out := &in.TemporalNamespaceName, &out.TemporalNamespaceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemporalNamespaceNameRef != nil {
+		in, out := &in.TemporalNamespaceNameRef, &out.TemporalNamespaceNameRef
+		*out = new(commonv1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemporalNamespaceNameSelector != nil {
+		in, out := &in.TemporalNamespaceNameSelector, &out.TemporalNamespaceNameSelector
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VersionSets != nil {
+		in, out := &in.VersionSets, &out.VersionSets
+		*out = make([]BuildIdVersionSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerBuildIdCompatibilityParameters.
+func (in *WorkerBuildIdCompatibilityParameters) DeepCopy() *WorkerBuildIdCompatibilityParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerBuildIdCompatibilityParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerBuildIdCompatibilitySpec) DeepCopyInto(out *WorkerBuildIdCompatibilitySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerBuildIdCompatibilitySpec.
+func (in *WorkerBuildIdCompatibilitySpec) DeepCopy() *WorkerBuildIdCompatibilitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerBuildIdCompatibilitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerBuildIdCompatibilityStatus) DeepCopyInto(out *WorkerBuildIdCompatibilityStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerBuildIdCompatibilityStatus.
+func (in *WorkerBuildIdCompatibilityStatus) DeepCopy() *WorkerBuildIdCompatibilityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerBuildIdCompatibilityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
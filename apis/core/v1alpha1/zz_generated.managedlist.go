@@ -19,6 +19,33 @@ package v1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this NamespaceFailoverList.
+func (l *NamespaceFailoverList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this RemoteClusterList.
+func (l *RemoteClusterList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this ScheduleList.
+func (l *ScheduleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this SearchAttributeList.
 func (l *SearchAttributeList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -28,6 +55,24 @@ func (l *SearchAttributeList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this SearchAttributeSetList.
+func (l *SearchAttributeSetList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this TaskQueueList.
+func (l *TaskQueueList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this TemporalNamespaceList.
 func (l *TemporalNamespaceList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -36,3 +81,12 @@ func (l *TemporalNamespaceList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this WorkerBuildIdCompatibilityList.
+func (l *WorkerBuildIdCompatibilityList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -24,10 +24,157 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this NamespaceFailover.
+func (mg *NamespaceFailover) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TemporalNamespaceName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.TemporalNamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.TemporalNamespaceNameSelector,
+		To: reference.To{
+			List:    &TemporalNamespaceList{},
+			Managed: &TemporalNamespace{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TemporalNamespaceName")
+	}
+	mg.Spec.ForProvider.TemporalNamespaceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TemporalNamespaceNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Schedule.
+func (mg *Schedule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TemporalNamespaceName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.TemporalNamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.TemporalNamespaceNameSelector,
+		To: reference.To{
+			List:    &TemporalNamespaceList{},
+			Managed: &TemporalNamespace{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TemporalNamespaceName")
+	}
+	mg.Spec.ForProvider.TemporalNamespaceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TemporalNamespaceNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this SearchAttribute.
 func (mg *SearchAttribute) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
 
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TemporalNamespaceName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.TemporalNamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.TemporalNamespaceNameSelector,
+		To: reference.To{
+			List:    &TemporalNamespaceList{},
+			Managed: &TemporalNamespace{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TemporalNamespaceName")
+	}
+	mg.Spec.ForProvider.TemporalNamespaceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TemporalNamespaceNameRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.TemporalNamespaceNames,
+		Extract:       reference.ExternalName(),
+		References:    mg.Spec.ForProvider.TemporalNamespaceNamesRefs,
+		Selector:      mg.Spec.ForProvider.TemporalNamespaceNamesSelector,
+		To: reference.To{
+			List:    &TemporalNamespaceList{},
+			Managed: &TemporalNamespace{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TemporalNamespaceNames")
+	}
+	mg.Spec.ForProvider.TemporalNamespaceNames = mrsp.ResolvedValues
+	mg.Spec.ForProvider.TemporalNamespaceNamesRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this SearchAttributeSet.
+func (mg *SearchAttributeSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TemporalNamespaceName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.TemporalNamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.TemporalNamespaceNameSelector,
+		To: reference.To{
+			List:    &TemporalNamespaceList{},
+			Managed: &TemporalNamespace{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TemporalNamespaceName")
+	}
+	mg.Spec.ForProvider.TemporalNamespaceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TemporalNamespaceNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TaskQueue.
+func (mg *TaskQueue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TemporalNamespaceName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.TemporalNamespaceNameRef,
+		Selector:     mg.Spec.ForProvider.TemporalNamespaceNameSelector,
+		To: reference.To{
+			List:    &TemporalNamespaceList{},
+			Managed: &TemporalNamespace{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TemporalNamespaceName")
+	}
+	mg.Spec.ForProvider.TemporalNamespaceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TemporalNamespaceNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this WorkerBuildIdCompatibility.
+func (mg *WorkerBuildIdCompatibility) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
 	var rsp reference.ResolutionResponse
 	var err error
 
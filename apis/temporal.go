@@ -20,6 +20,7 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	cloudv1alpha1 "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
 	corev1alpha1 "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
 	temporalv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
 )
@@ -29,6 +30,7 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		temporalv1alpha1.SchemeBuilder.AddToScheme,
 		corev1alpha1.SchemeBuilder.AddToScheme,
+		cloudv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
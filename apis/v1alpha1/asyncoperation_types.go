@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// AsyncOperationStatus tracks the asynchronous operation, by which Temporal
+// or Temporal Cloud applies the last change of a managed resource, e.g. the
+// update of a Temporal Cloud resource or the deletion of a namespace. The
+// managed resource is not ready until the operation succeeded.
+type AsyncOperationStatus struct {
+	// Id of the asynchronous operation. It is cleared, when the operation
+	// completed.
+	// +optional
+	Id string `json:"id,omitempty"`
+
+	// State of the asynchronous operation, e.g. pending, in_progress,
+	// fulfilled or failed.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// FailureReason explains, why the asynchronous operation failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
@@ -25,10 +25,40 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+const (
+	// ProviderModeSelfHosted connects to a self-hosted Temporal server.
+	ProviderModeSelfHosted = "SelfHosted"
+
+	// ProviderModeCloud connects to the Temporal Cloud Operations API.
+	ProviderModeCloud = "Cloud"
+)
+
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// Mode is the kind of Temporal, which the credentials connect to.
+	// Managed resources, which exist in a self-hosted Temporal server and in
+	// Temporal Cloud (e.g. SearchAttributes), use it to choose the API.
+	// +kubebuilder:validation:Enum=SelfHosted;Cloud
+	// +kubebuilder:default=SelfHosted
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// AllowedNamespaceNamePattern is an optional regular expression, which the
+	// whole name of every TemporalNamespace using this ProviderConfig must match.
+	// TemporalNamespaces with a non-matching name are rejected.
+	// +optional
+	AllowedNamespaceNamePattern *string `json:"allowedNamespaceNamePattern,omitempty"`
+
+	// MaxNamespaces is an optional limit of TemporalNamespaces, which can be
+	// created or adopted using this ProviderConfig. Additional
+	// TemporalNamespaces are neither created nor adopted and get a
+	// QuotaExceeded condition.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxNamespaces *int `json:"maxNamespaces,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -43,6 +73,48 @@ type ProviderCredentials struct {
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// ConnectionTest is the result of the last on-demand connection test.
+	// +optional
+	ConnectionTest *ConnectionTestResult `json:"connectionTest,omitempty"`
+
+	// OrphanedNamespaces is the result of the last orphaned namespace report.
+	// +optional
+	OrphanedNamespaces *OrphanedNamespaceReport `json:"orphanedNamespaces,omitempty"`
+}
+
+// A ConnectionTestResult reflects the result of an on-demand connection test.
+type ConnectionTestResult struct {
+	// Time at which the connection was tested.
+	Time metav1.Time `json:"time"`
+
+	// ServerVersion reported by the Temporal server.
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// LatencyMilliseconds it took to dial the server and receive the system
+	// info.
+	// +optional
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+
+	// Error which occurred while testing the connection.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// An OrphanedNamespaceReport lists the namespaces of the Temporal server, which
+// are not managed by any TemporalNamespace using the ProviderConfig.
+type OrphanedNamespaceReport struct {
+	// Time at which the report was created.
+	Time metav1.Time `json:"time"`
+
+	// Namespaces which exist on the Temporal server, but are not managed.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Error which occurred while creating the report.
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -69,6 +141,11 @@ type ProviderConfigList struct {
 	Items           []ProviderConfig `json:"items"`
 }
 
+// AnnotationKeyTestConnection triggers an on-demand connection test of a
+// ProviderConfig, if it is set to "true". The annotation is removed after the
+// test has been executed.
+const AnnotationKeyTestConnection = "temporal.crossplane.io/test-connection"
+
 // ProviderConfig type metadata.
 var (
 	ProviderConfigKind             = reflect.TypeOf(ProviderConfig{}).Name()
@@ -24,6 +24,58 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AsyncOperationStatus) DeepCopyInto(out *AsyncOperationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AsyncOperationStatus.
+func (in *AsyncOperationStatus) DeepCopy() *AsyncOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AsyncOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionTestResult) DeepCopyInto(out *ConnectionTestResult) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionTestResult.
+func (in *ConnectionTestResult) DeepCopy() *ConnectionTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedNamespaceReport) DeepCopyInto(out *OrphanedNamespaceReport) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedNamespaceReport.
+func (in *OrphanedNamespaceReport) DeepCopy() *OrphanedNamespaceReport {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedNamespaceReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
@@ -87,6 +139,16 @@ func (in *ProviderConfigList) DeepCopyObject() runtime.Object {
 func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	*out = *in
 	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.AllowedNamespaceNamePattern != nil {
+		in, out := &in.AllowedNamespaceNamePattern, &out.AllowedNamespaceNamePattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxNamespaces != nil {
+		in, out := &in.MaxNamespaces, &out.MaxNamespaces
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -103,6 +165,16 @@ func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
 func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
 	*out = *in
 	in.ProviderConfigStatus.DeepCopyInto(&out.ProviderConfigStatus)
+	if in.ConnectionTest != nil {
+		in, out := &in.ConnectionTest, &out.ConnectionTest
+		*out = new(ConnectionTestResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrphanedNamespaces != nil {
+		in, out := &in.OrphanedNamespaces, &out.OrphanedNamespaces
+		*out = new(OrphanedNamespaceReport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
@@ -21,8 +21,11 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
+	"go.uber.org/zap/zapcore"
+	"golang.org/x/exp/slog"
 	"gopkg.in/alecthomas/kingpin.v2"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +33,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -40,29 +44,80 @@ import (
 
 	"github.com/denniskniep/provider-temporal/apis"
 	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/clients"
 	temporal "github.com/denniskniep/provider-temporal/internal/controller"
 	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/pollinterval"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	temporalwebhook "github.com/denniskniep/provider-temporal/internal/webhook"
 )
 
+// defaultCertsDir is the directory, in which Crossplane provisions the server
+// certificate of the webhooks.
+const defaultCertsDir = "/tls/server"
+
+// webhookCertsProvisioned returns true, if the server certificate of the
+// webhooks is provisioned. Crossplane provisions it for the provider in the
+// cluster, therefore the webhooks are only opt-in when running out-of-cluster.
+func webhookCertsProvisioned() bool {
+	dir := os.Getenv("TLS_SERVER_CERTS_DIR")
+	if dir == "" {
+		dir = defaultCertsDir
+	}
+
+	_, err := os.Stat(filepath.Join(dir, "tls.crt"))
+	return err == nil
+}
+
 func main() {
 	var (
 		app            = kingpin.New(filepath.Base(os.Args[0]), "temporal support for Crossplane.").DefaultEnvars()
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		leaderElection = app.Flag("leader-election", "Use leader election for the controller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 
+		logLevel  = app.Flag("log-level", "The minimum level of the logs of the controllers and of the Temporal clients. --debug sets it to debug.").Default("info").Envar("LOG_LEVEL").Enum("debug", "info", "warn", "error")
+		logFormat = app.Flag("log-format", "The format of the logs of the controllers and of the Temporal clients.").Default(clients.LogFormatJSON).Envar("LOG_FORMAT").Enum(clients.LogFormatJSON, clients.LogFormatText)
+
 		syncInterval     = app.Flag("sync", "How often all resources will be double-checked for drift from the desired state.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
+		pollJitter       = app.Flag("poll-jitter", "The maximum duration, which is randomly added to or subtracted from the poll interval of each check, to spread the load on Temporal.").Default("10s").Duration()
+		operationTimeout = app.Flag("operation-timeout", "The timeout of each Observe, Create, Update and Delete operation of a resource against Temporal. 0 disables the timeout.").Default("30s").Duration()
+		syncTimeout      = app.Flag("sync-timeout", "The timeout of a single sync of a resource, i.e. of its connect, observe, create, update and delete operations against Temporal together. Syncs exceeding it fail and are retried.").Default("1m").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		globalRate       = app.Flag("global-reconcile-rate", "The rate per second of the token bucket, which limits the reconciles of all controllers together. 0 uses --max-reconcile-rate.").Default("0").Int()
+		backoffBaseDelay = app.Flag("backoff-base-delay", "The delay after the first failed reconcile of a resource, which doubles with each further failed reconcile.").Default("1s").Duration()
+		backoffMaxDelay  = app.Flag("backoff-max-delay", "The maximum delay of failed reconciles of a resource.").Default("60s").Duration()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+
+		enableOrphanedNamespaceReport = app.Flag("enable-orphaned-namespace-report", "Periodically report namespaces, which are not managed by any TemporalNamespace.").Default("false").Envar("ENABLE_ORPHANED_NAMESPACE_REPORT").Bool()
+
+		enableWebhooks = app.Flag("enable-webhooks", "Enable the admission webhooks. Defaults to true, if the server certificate of the webhooks is provisioned in TLS_SERVER_CERTS_DIR, e.g. by Crossplane.").Default(strconv.FormatBool(webhookCertsProvisioned())).Envar("ENABLE_WEBHOOKS").Bool()
+		certsDir       = app.Flag("certs-dir", "The directory that contains the server key and certificate of the webhooks.").Default(defaultCertsDir).Envar("TLS_SERVER_CERTS_DIR").String()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
 	ctrl.SetLogger(zap.New(zap.WriteTo(io.Discard)))
 
-	zl := zap.New(zap.UseDevMode(*debug))
+	if *debug {
+		*logLevel = "debug"
+	}
+
+	zapLevel, err := zapcore.ParseLevel(*logLevel)
+	kingpin.FatalIfError(err, "Cannot parse log level")
+
+	var slogLevel slog.Level
+	kingpin.FatalIfError(slogLevel.UnmarshalText([]byte(*logLevel)), "Cannot parse log level")
+
+	zapEncoder := zap.JSONEncoder()
+	if *logFormat == clients.LogFormatText {
+		zapEncoder = zap.ConsoleEncoder()
+	}
+
+	zl := zap.New(zap.UseDevMode(*debug), zap.Level(zapLevel), zapEncoder)
 	log := logging.NewLogrLogger(zl.WithName("provider-temporal"))
 	if *debug {
 		// The controller-runtime runs with a no-op logger by default. It is
@@ -71,6 +126,9 @@ func main() {
 		ctrl.SetLogger(zl)
 	}
 
+	clients.SetLogLevel(slogLevel)
+	clients.SetLogFormat(*logFormat)
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -91,15 +149,23 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: *certsDir,
+		}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add temporal APIs to scheme")
 
+	if *globalRate <= 0 {
+		*globalRate = *maxReconcileRate
+	}
+
 	o := controller.Options{
 		Logger:                  log,
 		MaxConcurrentReconciles: *maxReconcileRate,
 		PollInterval:            *pollInterval,
-		GlobalRateLimiter:       ratelimiter.NewGlobal(*maxReconcileRate),
+		GlobalRateLimiter:       ratelimiter.NewGlobal(*globalRate),
 		Features:                &feature.Flags{},
 	}
 
@@ -127,6 +193,19 @@ func main() {
 		log.Info("Alpha feature enabled", "flag", features.EnableAlphaManagementPolicies)
 	}
 
+	if *enableOrphanedNamespaceReport {
+		o.Features.Enable(features.EnableOrphanedNamespaceReport)
+		log.Info("Feature enabled", "flag", features.EnableOrphanedNamespaceReport)
+	}
+
+	pollinterval.SetJitter(*pollJitter)
+	timeout.SetOperationTimeout(*operationTimeout)
+	timeout.SetSyncTimeout(*syncTimeout)
+	ratelimit.SetBackoff(*backoffBaseDelay, *backoffMaxDelay)
+
 	kingpin.FatalIfError(temporal.Setup(mgr, o), "Cannot setup temporal controllers")
+	if *enableWebhooks {
+		kingpin.FatalIfError(temporalwebhook.Setup(mgr), "Cannot setup temporal webhooks")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package asyncoperation tracks the operations, which Temporal or Temporal
+// Cloud apply asynchronously, e.g. updates of Temporal Cloud resources or the
+// deletion of namespaces, in the status of their managed resources. Managed
+// resources with a running operation are polled more often, so that they
+// become ready soon after their operation completed.
+package asyncoperation
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+// The states of asynchronous operations.
+const (
+	StatePending    = "pending"
+	StateInProgress = "in_progress"
+	StateFailed     = "failed"
+	StateCancelled  = "cancelled"
+	StateFulfilled  = "fulfilled"
+)
+
+// PollInterval is the poll interval of managed resources, whose operation is
+// running.
+const PollInterval = 10 * time.Second
+
+const errNotFulfilled = "asynchronous operation '%s' is %s: %s"
+
+// A Tracker is a managed resource, which tracks its asynchronous operations
+// in its status.
+type Tracker interface {
+	GetAsyncOperation() *v1alpha1.AsyncOperationStatus
+}
+
+// Track stores the id of the asynchronous operation, which applies a change
+// of a managed resource, in its status.
+func Track(status *v1alpha1.AsyncOperationStatus, id string) {
+	if id == "" {
+		return
+	}
+
+	status.Id = id
+	status.State = StatePending
+	status.FailureReason = ""
+}
+
+// Running returns true, if an asynchronous operation is tracked in the status.
+func Running(status *v1alpha1.AsyncOperationStatus) bool {
+	return status.Id != ""
+}
+
+// IsDone returns true, if the state is the state of a completed operation,
+// which either succeeded or not.
+func IsDone(state string) bool {
+	return state == StateFailed || state == StateCancelled || state == StateFulfilled
+}
+
+// Progress records the state of the tracked operation and returns true, while
+// it is running. The id is cleared, once the operation is done. An error is
+// returned, if the operation did not succeed, so that the change is applied
+// again.
+func Progress(status *v1alpha1.AsyncOperationStatus, state string, failureReason string) (bool, error) {
+	status.State = state
+	status.FailureReason = failureReason
+	if !IsDone(state) {
+		return true, nil
+	}
+
+	id := status.Id
+	status.Id = ""
+	if state != StateFulfilled {
+		return false, errors.Errorf(errNotFulfilled, id, state, failureReason)
+	}
+	return false, nil
+}
+
+// Forget clears the tracked operation, e.g. if it is not known anymore.
+func Forget(status *v1alpha1.AsyncOperationStatus) {
+	status.Id = ""
+}
+
+// Requeue returns the poll interval of the managed resource, which is
+// shortened to PollInterval, while an asynchronous operation of it is
+// running.
+func Requeue(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	tracker, ok := mg.(Tracker)
+	if !ok || !Running(tracker.GetAsyncOperation()) || pollInterval <= PollInterval {
+		return pollInterval
+	}
+	return PollInterval
+}
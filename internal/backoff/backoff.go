@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backoff delays the reconciles of managed resources, whose requests
+// were rejected by Temporal with ResourceExhausted, instead of retrying them
+// at the rate of the workqueue.
+package backoff
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+const (
+	// minDelay is the delay after the first ResourceExhausted error of a
+	// managed resource, if the server did not request a delay.
+	minDelay = 5 * time.Second
+
+	// maxDelay limits the delay, which doubles with each consecutive
+	// ResourceExhausted error of a managed resource.
+	maxDelay = 5 * time.Minute
+)
+
+// A Tracker tracks the managed resources of a controller, whose requests were
+// rejected with ResourceExhausted, by their name.
+type Tracker struct {
+	mutex    sync.Mutex
+	now      func() time.Time
+	delays   map[string]time.Time
+	attempts map[string]int
+}
+
+// NewTracker returns a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		now:      time.Now,
+		delays:   map[string]time.Time{},
+		attempts: map[string]int{},
+	}
+}
+
+// NewConnector returns a connector, whose external clients record
+// ResourceExhausted errors of the external clients of the passed connector.
+func (t *Tracker) NewConnector(c managed.ExternalConnectDisconnecter) managed.ExternalConnectDisconnecter {
+	return &connector{ExternalConnectDisconnecter: c, tracker: t}
+}
+
+// NewReconciler returns a reconciler, which does not reconcile a managed
+// resource until its delay elapsed and requeues it after its delay instead of
+// returning the error of the reconcile.
+func (t *Tracker) NewReconciler(r reconcile.Reconciler) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		if delay := t.remaining(req.Name); delay > 0 {
+			return reconcile.Result{RequeueAfter: delay}, nil
+		}
+
+		result, err := r.Reconcile(ctx, req)
+		if delay := t.remaining(req.Name); delay > 0 {
+			return reconcile.Result{RequeueAfter: delay}, nil
+		}
+		return result, err
+	})
+}
+
+// record delays the managed resource, if the error is a ResourceExhausted
+// error, otherwise it resets its delay.
+func (t *Tracker) record(name string, err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	requested, exhausted := temporal.ResourceExhausted(err)
+	if !exhausted {
+		delete(t.delays, name)
+		delete(t.attempts, name)
+		return
+	}
+
+	delay := minDelay << t.attempts[name]
+	if delay <= 0 || delay > maxDelay {
+		delay = maxDelay
+	}
+	if requested > delay {
+		delay = requested
+	}
+
+	t.attempts[name]++
+	t.delays[name] = t.now().Add(delay)
+}
+
+// remaining returns the remaining delay of the managed resource.
+func (t *Tracker) remaining(name string) time.Duration {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	until, ok := t.delays[name]
+	if !ok {
+		return 0
+	}
+	return until.Sub(t.now())
+}
+
+type connector struct {
+	managed.ExternalConnectDisconnecter
+	tracker *Tracker
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	client, err := c.ExternalConnectDisconnecter.Connect(ctx, mg)
+	if err != nil {
+		c.tracker.record(mg.GetName(), err)
+		return nil, err
+	}
+	return &external{client: client, tracker: c.tracker}, nil
+}
+
+type external struct {
+	client  managed.ExternalClient
+	tracker *Tracker
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	observation, err := e.client.Observe(ctx, mg)
+	e.tracker.record(mg.GetName(), err)
+	return observation, err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	creation, err := e.client.Create(ctx, mg)
+	e.tracker.record(mg.GetName(), err)
+	return creation, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	update, err := e.client.Update(ctx, mg)
+	e.tracker.record(mg.GetName(), err)
+	return update, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	err := e.client.Delete(ctx, mg)
+	e.tracker.record(mg.GetName(), err)
+	return err
+}
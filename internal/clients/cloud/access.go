@@ -0,0 +1,81 @@
+package cloud
+
+import (
+	"sort"
+	"strings"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+// accountRoles maps the account roles of the Temporal Cloud Operations API
+// to the account roles of the managed resources.
+var accountRoles = map[string]string{
+	"owner":        "Owner",
+	"admin":        "Admin",
+	"developer":    "Developer",
+	"financeadmin": "FinanceAdmin",
+	"read":         "Read",
+}
+
+// namespacePermissions maps the namespace permissions of the Temporal Cloud
+// Operations API to the namespace permissions of the managed resources.
+var namespacePermissions = map[string]string{
+	"admin": "Admin",
+	"write": "Write",
+	"read":  "Read",
+}
+
+type access struct {
+	AccountAccess     *accountAccess                  `json:"accountAccess,omitempty"`
+	NamespaceAccesses map[string]namespaceAccessEntry `json:"namespaceAccesses,omitempty"`
+}
+
+type accountAccess struct {
+	Role string `json:"role"`
+}
+
+type namespaceAccessEntry struct {
+	Permission string `json:"permission"`
+}
+
+// toApiValue maps an account role or namespace permission of a managed
+// resource to the value of the Temporal Cloud Operations API.
+func toApiValue(value string) string {
+	return strings.ToLower(value)
+}
+
+// fromApiValue maps an account role or namespace permission of the Temporal
+// Cloud Operations API to the value of a managed resource. Enum values of
+// newer API versions like ROLE_FINANCE_ADMIN are mapped as well.
+func fromApiValue(values map[string]string, value string, prefix string) string {
+	normalized := strings.TrimPrefix(strings.ToLower(value), prefix)
+	normalized = strings.ReplaceAll(normalized, "_", "")
+	if mapped, ok := values[normalized]; ok {
+		return mapped
+	}
+	return value
+}
+
+func mapAccountRole(access access) string {
+	if access.AccountAccess == nil {
+		return ""
+	}
+	return fromApiValue(accountRoles, access.AccountAccess.Role, "role_")
+}
+
+func mapNamespaceAccesses(access access) []cloud.NamespaceAccess {
+	namespaces := make([]string, 0, len(access.NamespaceAccesses))
+	for namespace := range access.NamespaceAccesses {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	var namespaceAccesses []cloud.NamespaceAccess
+	for _, namespace := range namespaces {
+		namespaceAccesses = append(namespaceAccesses, cloud.NamespaceAccess{
+			Namespace:  namespace,
+			Permission: fromApiValue(namespacePermissions, access.NamespaceAccesses[namespace].Permission, "permission_"),
+		})
+	}
+	return namespaceAccesses
+}
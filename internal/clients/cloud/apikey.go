@@ -0,0 +1,237 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+const (
+	OwnerTypeServiceAccount = "ServiceAccount"
+	OwnerTypeUser           = "User"
+)
+
+// ownerTypes maps the owner types of the Temporal Cloud Operations API to the
+// owner types of the CloudApiKey.
+var ownerTypes = map[string]string{
+	"serviceaccount": OwnerTypeServiceAccount,
+	"user":           OwnerTypeUser,
+}
+
+type ApiKeyService interface {
+	DescribeApiKey(ctx context.Context, keyId string) (*cloud.CloudApiKeyObservation, error)
+
+	// CreateApiKey returns the id and the secret token of the created API
+	// key. The token is only available on creation.
+	CreateApiKey(ctx context.Context, apiKey *cloud.CloudApiKeyParameters) (string, string, error)
+	UpdateApiKey(ctx context.Context, keyId string, apiKey *cloud.CloudApiKeyParameters) (string, error)
+	DeleteApiKey(ctx context.Context, keyId string, resourceVersion string) error
+
+	MapToApiKeyCompare(apiKey interface{}) (*ApiKeyCompare, error)
+
+	AsyncOperationService
+
+	Close()
+}
+
+type ApiKeyCompare struct {
+	DisplayName string `json:"displayName"`
+	Description string `json:"description,omitempty"`
+	Disabled    bool   `json:"disabled"`
+}
+
+type apiKeySpec struct {
+	OwnerId     string `json:"ownerId"`
+	OwnerType   string `json:"ownerType"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description,omitempty"`
+	ExpiryTime  string `json:"expiryTime,omitempty"`
+	Disabled    bool   `json:"disabled,omitempty"`
+}
+
+type apiKey struct {
+	Id              string     `json:"id"`
+	ResourceVersion string     `json:"resourceVersion"`
+	Spec            apiKeySpec `json:"spec"`
+	State           string     `json:"state"`
+}
+
+type getApiKeyResponse struct {
+	ApiKey *apiKey `json:"apiKey"`
+}
+
+type createApiKeyRequest struct {
+	Spec             apiKeySpec `json:"spec"`
+	AsyncOperationId string     `json:"asyncOperationId,omitempty"`
+}
+
+type createApiKeyResponse struct {
+	KeyId string `json:"keyId"`
+	Token string `json:"token"`
+}
+
+type updateApiKeyRequest struct {
+	Spec             apiKeySpec `json:"spec"`
+	ResourceVersion  string     `json:"resourceVersion"`
+	AsyncOperationId string     `json:"asyncOperationId,omitempty"`
+}
+
+func (s *CloudServiceImpl) MapToApiKeyCompare(apiKey interface{}) (*ApiKeyCompare, error) {
+	apiKeyJson, err := json.Marshal(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiKeyCompare = ApiKeyCompare{}
+	err = json.Unmarshal(apiKeyJson, &apiKeyCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKeyCompare, nil
+}
+
+// DescribeApiKey returns the API key with the given id or nil if it does not
+// exist. The secret token is not returned.
+func (s *CloudServiceImpl) DescribeApiKey(ctx context.Context, keyId string) (*cloud.CloudApiKeyObservation, error) {
+	apiKey, err := s.getApiKey(ctx, keyId)
+	if err != nil || apiKey == nil {
+		return nil, err
+	}
+
+	observation := &cloud.CloudApiKeyObservation{
+		Id:              apiKey.Id,
+		DisplayName:     apiKey.Spec.DisplayName,
+		Description:     apiKey.Spec.Description,
+		OwnerType:       fromApiValue(ownerTypes, strings.ReplaceAll(apiKey.Spec.OwnerType, "-", ""), "owner_type_"),
+		OwnerId:         apiKey.Spec.OwnerId,
+		Disabled:        apiKey.Spec.Disabled,
+		State:           normalizeState(apiKey.State),
+		ResourceVersion: apiKey.ResourceVersion,
+	}
+
+	if apiKey.Spec.ExpiryTime != "" {
+		expiryTime, err := time.Parse(time.RFC3339Nano, apiKey.Spec.ExpiryTime)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse expiryTime")
+		}
+		observation.ExpiryTime = &metav1.Time{Time: expiryTime}
+	}
+
+	return observation, nil
+}
+
+func (s *CloudServiceImpl) CreateApiKey(ctx context.Context, apiKey *cloud.CloudApiKeyParameters) (string, string, error) {
+	spec, err := mapToApiKeySpec(apiKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	request := &createApiKeyRequest{
+		Spec:             spec,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	response := &createApiKeyResponse{}
+	err = s.do(ctx, http.MethodPost, "/cloud/api-keys", nil, request, response)
+	if err != nil {
+		return "", "", err
+	}
+
+	return response.KeyId, response.Token, nil
+}
+
+// UpdateApiKey changes the display name, the description and whether the API
+// key is disabled and returns the id of the asynchronous operation. The owner
+// and the expiry time are kept.
+func (s *CloudServiceImpl) UpdateApiKey(ctx context.Context, keyId string, apiKey *cloud.CloudApiKeyParameters) (string, error) {
+	current, err := s.getApiKey(ctx, keyId)
+	if err != nil {
+		return "", err
+	}
+
+	if current == nil {
+		return "", &APIError{StatusCode: http.StatusNotFound, Message: "API key '" + keyId + "' not found"}
+	}
+
+	spec := current.Spec
+	spec.DisplayName = apiKey.DisplayName
+	spec.Description = apiKey.Description
+	spec.Disabled = apiKey.Disabled
+
+	request := &updateApiKeyRequest{
+		Spec:             spec,
+		ResourceVersion:  current.ResourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/api-keys/"+url.PathEscape(keyId), nil, request, request.AsyncOperationId)
+}
+
+func (s *CloudServiceImpl) DeleteApiKey(ctx context.Context, keyId string, resourceVersion string) error {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	err := s.do(ctx, http.MethodDelete, "/cloud/api-keys/"+url.PathEscape(keyId), query, nil, nil)
+
+	if IsNotFound(err) {
+		s.logger.Debug("ApiKey '" + keyId + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+func (s *CloudServiceImpl) getApiKey(ctx context.Context, keyId string) (*apiKey, error) {
+	response := &getApiKeyResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/api-keys/"+url.PathEscape(keyId), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("ApiKey '" + keyId + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.ApiKey == nil || normalizeState(response.ApiKey.State) == "deleted" {
+		return nil, nil
+	}
+
+	return response.ApiKey, nil
+}
+
+func mapToApiKeySpec(apiKey *cloud.CloudApiKeyParameters) (apiKeySpec, error) {
+	spec := apiKeySpec{
+		DisplayName: apiKey.DisplayName,
+		Description: apiKey.Description,
+		ExpiryTime:  apiKey.ExpiryTime.UTC().Format(time.RFC3339),
+		Disabled:    apiKey.Disabled,
+	}
+
+	switch {
+	case apiKey.ServiceAccountId != nil && apiKey.UserId != nil:
+		return spec, errors.New("API key can not be owned by a service account and a user")
+	case apiKey.ServiceAccountId != nil:
+		spec.OwnerId = *apiKey.ServiceAccountId
+		spec.OwnerType = "service-account"
+	case apiKey.UserId != nil:
+		spec.OwnerId = *apiKey.UserId
+		spec.OwnerType = "user"
+	default:
+		return spec, errors.New("API key requires a service account or a user as owner")
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,79 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+func TestCreateApiKey(t *testing.T) {
+	serviceAccountId := "sa1"
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/cloud/api-keys" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		request := &createApiKeyRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Error(err)
+		}
+		if request.Spec.OwnerId != serviceAccountId || request.Spec.OwnerType != "service-account" || request.Spec.ExpiryTime != "2030-01-02T03:04:05Z" {
+			t.Errorf("unexpected request %v", request.Spec)
+		}
+
+		_, _ = w.Write([]byte(`{"keyId":"key1","token":"secret"}`))
+	})
+
+	keyId, token, err := service.CreateApiKey(context.Background(), &cloud.CloudApiKeyParameters{
+		DisplayName:      "key1",
+		ServiceAccountId: &serviceAccountId,
+		ExpiryTime:       metav1.NewTime(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if keyId != "key1" || token != "secret" {
+		t.Fatalf("unexpected keyId '%s' or token '%s'", keyId, token)
+	}
+}
+
+func TestCreateApiKeyWithoutOwner(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	_, _, err := service.CreateApiKey(context.Background(), &cloud.CloudApiKeyParameters{
+		DisplayName: "key2",
+		ExpiryTime:  metav1.NewTime(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)),
+	})
+	if err == nil {
+		t.Fatal("expected error, because the API key has no owner")
+	}
+}
+
+func TestDescribeApiKey(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"apiKey":{"id":"key3","resourceVersion":"rv1","state":"active",
+			"spec":{"ownerId":"sa1","ownerType":"OWNER_TYPE_SERVICE_ACCOUNT","displayName":"key3","expiryTime":"2030-01-02T03:04:05Z"}}}`))
+	})
+
+	apiKey, err := service.DescribeApiKey(context.Background(), "key3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if apiKey.OwnerType != OwnerTypeServiceAccount || apiKey.OwnerId != "sa1" {
+		t.Fatalf("unexpected owner %s '%s'", apiKey.OwnerType, apiKey.OwnerId)
+	}
+
+	if !apiKey.ExpiryTime.Time.Equal(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Fatalf("unexpected expiryTime %v", apiKey.ExpiryTime)
+	}
+}
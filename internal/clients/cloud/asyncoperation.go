@@ -0,0 +1,104 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/asyncoperation"
+)
+
+const (
+	AsyncOperationStatePending    = asyncoperation.StatePending
+	AsyncOperationStateInProgress = asyncoperation.StateInProgress
+	AsyncOperationStateFailed     = asyncoperation.StateFailed
+	AsyncOperationStateCancelled  = asyncoperation.StateCancelled
+	AsyncOperationStateFulfilled  = asyncoperation.StateFulfilled
+)
+
+// AsyncOperation tracks a change, which Temporal Cloud applies asynchronously.
+type AsyncOperation struct {
+	Id            string `json:"id"`
+	State         string `json:"state"`
+	OperationType string `json:"operationType,omitempty"`
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// IsDone returns true, if the operation completed either successfully or not.
+func (o *AsyncOperation) IsDone() bool {
+	return asyncoperation.IsDone(o.State)
+}
+
+type AsyncOperationService interface {
+	GetAsyncOperation(ctx context.Context, asyncOperationId string) (*AsyncOperation, error)
+}
+
+type asyncOperationResponse struct {
+	AsyncOperation *AsyncOperation `json:"asyncOperation"`
+}
+
+// GetAsyncOperation returns the asynchronous operation with the given id or
+// nil if it does not exist.
+func (s *CloudServiceImpl) GetAsyncOperation(ctx context.Context, asyncOperationId string) (*AsyncOperation, error) {
+	response := &asyncOperationResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/operations/"+url.PathEscape(asyncOperationId), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("AsyncOperation '" + asyncOperationId + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.AsyncOperation != nil {
+		response.AsyncOperation.State = normalizeState(response.AsyncOperation.State)
+	}
+	return response.AsyncOperation, nil
+}
+
+// ObserveAsyncOperation polls the asynchronous operation, which is tracked in
+// the status, and returns true, while it is not done. The id is cleared, once
+// the operation is done. An error is returned, if the operation did not
+// succeed, so that the change is applied again.
+func ObserveAsyncOperation(ctx context.Context, service AsyncOperationService, status *v1alpha1.AsyncOperationStatus) (bool, error) {
+	if !asyncoperation.Running(status) {
+		return false, nil
+	}
+
+	operation, err := service.GetAsyncOperation(ctx, status.Id)
+	if err != nil {
+		return false, err
+	}
+
+	// Temporal Cloud does not keep completed operations forever
+	if operation == nil {
+		asyncoperation.Forget(status)
+		return false, nil
+	}
+
+	return asyncoperation.Progress(status, operation.State, operation.FailureReason)
+}
+
+// startAsyncOperation sends the request of a change, which Temporal Cloud
+// applies asynchronously, and returns the id of the started operation.
+func (s *CloudServiceImpl) startAsyncOperation(ctx context.Context, method string, path string, query url.Values, request interface{}, requestedId string) (string, error) {
+	response := &asyncOperationResponse{}
+	err := s.do(ctx, method, path, query, request, response)
+	if err != nil {
+		return "", err
+	}
+
+	return asyncOperationId(response, requestedId), nil
+}
+
+// asyncOperationId returns the id of the operation in the response, which
+// falls back to the id that was requested.
+func asyncOperationId(response *asyncOperationResponse, requestedId string) string {
+	if response.AsyncOperation != nil && response.AsyncOperation.Id != "" {
+		return response.AsyncOperation.Id
+	}
+	return requestedId
+}
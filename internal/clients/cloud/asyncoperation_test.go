@@ -0,0 +1,61 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/asyncoperation"
+)
+
+func TestObserveAsyncOperation(t *testing.T) {
+	state := "ASYNC_OPERATION_STATE_IN_PROGRESS"
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/cloud/operations/op1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"asyncOperation":{"id":"op1","state":"` + state + `"}}`))
+	})
+
+	status := &v1alpha1.AsyncOperationStatus{}
+	asyncoperation.Track(status, "op1")
+
+	pending, err := ObserveAsyncOperation(context.Background(), service, status)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !pending || status.Id != "op1" || status.State != AsyncOperationStateInProgress {
+		t.Fatalf("expected pending operation, got %v", status)
+	}
+
+	state = "ASYNC_OPERATION_STATE_FULFILLED"
+	pending, err = ObserveAsyncOperation(context.Background(), service, status)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pending || status.Id != "" || status.State != AsyncOperationStateFulfilled {
+		t.Fatalf("expected fulfilled operation, got %v", status)
+	}
+}
+
+func TestObserveFailedAsyncOperation(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"asyncOperation":{"id":"op2","state":"ASYNC_OPERATION_STATE_FAILED","failureReason":"invalid region"}}`))
+	})
+
+	status := &v1alpha1.AsyncOperationStatus{}
+	asyncoperation.Track(status, "op2")
+
+	_, err := ObserveAsyncOperation(context.Background(), service, status)
+	if err == nil {
+		t.Fatal("expected error, because the operation failed")
+	}
+
+	if status.Id != "" || status.FailureReason != "invalid region" {
+		t.Fatalf("expected cleared operation with failure reason, got %v", status)
+	}
+}
@@ -0,0 +1,173 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+type ExportSinkService interface {
+	DescribeExportSink(ctx context.Context, namespace string, name string) (*cloud.CloudNamespaceExportSinkObservation, error)
+
+	CreateExportSink(ctx context.Context, exportSink *cloud.CloudNamespaceExportSinkParameters) error
+	UpdateExportSink(ctx context.Context, resourceVersion string, exportSink *cloud.CloudNamespaceExportSinkParameters) (string, error)
+	DeleteExportSink(ctx context.Context, namespace string, name string, resourceVersion string) error
+
+	MapToExportSinkCompare(exportSink interface{}) (*ExportSinkCompare, error)
+
+	AsyncOperationService
+
+	Close()
+}
+
+type ExportSinkCompare struct {
+	Name    string                    `json:"name"`
+	Enabled bool                      `json:"enabled"`
+	S3      *cloud.CloudExportSinkS3  `json:"s3,omitempty"`
+	Gcs     *cloud.CloudExportSinkGcs `json:"gcs,omitempty"`
+}
+
+type exportSinkSpec struct {
+	Name    string                    `json:"name"`
+	Enabled bool                      `json:"enabled"`
+	S3      *cloud.CloudExportSinkS3  `json:"s3,omitempty"`
+	Gcs     *cloud.CloudExportSinkGcs `json:"gcs,omitempty"`
+}
+
+type exportSink struct {
+	Name                 string         `json:"name"`
+	ResourceVersion      string         `json:"resourceVersion"`
+	State                string         `json:"state"`
+	Spec                 exportSinkSpec `json:"spec"`
+	Health               string         `json:"health,omitempty"`
+	ErrorMessage         string         `json:"errorMessage,omitempty"`
+	LatestDataExportTime string         `json:"latestDataExportTime,omitempty"`
+}
+
+type getExportSinkResponse struct {
+	Sink *exportSink `json:"sink"`
+}
+
+type createExportSinkRequest struct {
+	Spec             exportSinkSpec `json:"spec"`
+	AsyncOperationId string         `json:"asyncOperationId,omitempty"`
+}
+
+type updateExportSinkRequest struct {
+	Spec             exportSinkSpec `json:"spec"`
+	ResourceVersion  string         `json:"resourceVersion"`
+	AsyncOperationId string         `json:"asyncOperationId,omitempty"`
+}
+
+func (s *CloudServiceImpl) MapToExportSinkCompare(exportSink interface{}) (*ExportSinkCompare, error) {
+	exportSinkJson, err := json.Marshal(exportSink)
+	if err != nil {
+		return nil, err
+	}
+
+	var exportSinkCompare = ExportSinkCompare{}
+	err = json.Unmarshal(exportSinkJson, &exportSinkCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exportSinkCompare, nil
+}
+
+// DescribeExportSink returns the export sink of the namespace or nil if it
+// does not exist.
+func (s *CloudServiceImpl) DescribeExportSink(ctx context.Context, namespace string, name string) (*cloud.CloudNamespaceExportSinkObservation, error) {
+	response := &getExportSinkResponse{}
+	err := s.do(ctx, http.MethodGet, exportSinkPath(namespace, name), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("ExportSink '" + name + "' of namespace '" + namespace + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sink := response.Sink
+	if sink == nil || normalizeState(sink.State) == "deleted" {
+		return nil, nil
+	}
+
+	return &cloud.CloudNamespaceExportSinkObservation{
+		Namespace:            namespace,
+		Name:                 sink.Spec.Name,
+		Enabled:              sink.Spec.Enabled,
+		S3:                   sink.Spec.S3,
+		Gcs:                  sink.Spec.Gcs,
+		State:                normalizeState(sink.State),
+		ResourceVersion:      sink.ResourceVersion,
+		Health:               strings.TrimPrefix(strings.ToLower(sink.Health), "health_"),
+		ErrorMessage:         sink.ErrorMessage,
+		LatestDataExportTime: sink.LatestDataExportTime,
+	}, nil
+}
+
+func (s *CloudServiceImpl) CreateExportSink(ctx context.Context, exportSink *cloud.CloudNamespaceExportSinkParameters) error {
+	if exportSink.Namespace == nil {
+		return errors.New("namespace not set")
+	}
+
+	request := &createExportSinkRequest{
+		Spec:             mapToExportSinkSpec(exportSink),
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.do(ctx, http.MethodPost, "/cloud/namespaces/"+url.PathEscape(*exportSink.Namespace)+"/export-sinks", nil, request, nil)
+}
+
+// UpdateExportSink changes the export sink and returns the id of the
+// asynchronous operation.
+func (s *CloudServiceImpl) UpdateExportSink(ctx context.Context, resourceVersion string, exportSink *cloud.CloudNamespaceExportSinkParameters) (string, error) {
+	if exportSink.Namespace == nil {
+		return "", errors.New("namespace not set")
+	}
+
+	request := &updateExportSinkRequest{
+		Spec:             mapToExportSinkSpec(exportSink),
+		ResourceVersion:  resourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, exportSinkPath(*exportSink.Namespace, exportSink.Name), nil, request, request.AsyncOperationId)
+}
+
+func (s *CloudServiceImpl) DeleteExportSink(ctx context.Context, namespace string, name string, resourceVersion string) error {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	err := s.do(ctx, http.MethodDelete, exportSinkPath(namespace, name), query, nil, nil)
+
+	if IsNotFound(err) {
+		s.logger.Debug("ExportSink '" + name + "' of namespace '" + namespace + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+func exportSinkPath(namespace string, name string) string {
+	return "/cloud/namespaces/" + url.PathEscape(namespace) + "/export-sinks/" + url.PathEscape(name)
+}
+
+func mapToExportSinkSpec(exportSink *cloud.CloudNamespaceExportSinkParameters) exportSinkSpec {
+	return exportSinkSpec{
+		Name:    exportSink.Name,
+		Enabled: exportSink.Enabled,
+		S3:      exportSink.S3,
+		Gcs:     exportSink.Gcs,
+	}
+}
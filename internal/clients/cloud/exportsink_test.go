@@ -0,0 +1,32 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestDescribeExportSink(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/cloud/namespaces/ns1.acct/export-sinks/sink1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"sink":{"name":"sink1","resourceVersion":"rv1","state":"active",
+			"health":"HEALTH_ERROR_USER_CONFIGURATION","errorMessage":"access denied",
+			"spec":{"name":"sink1","enabled":true,"s3":{"roleName":"export","bucketName":"histories","region":"eu-central-1","awsAccountId":"123456789012"}}}}`))
+	})
+
+	exportSink, err := service.DescribeExportSink(context.Background(), "ns1.acct", "sink1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if exportSink.Health != "error_user_configuration" || exportSink.ErrorMessage != "access denied" {
+		t.Fatalf("unexpected health '%s': %s", exportSink.Health, exportSink.ErrorMessage)
+	}
+
+	if exportSink.S3 == nil || exportSink.S3.BucketName != "histories" || exportSink.Gcs != nil {
+		t.Fatalf("unexpected destination %v %v", exportSink.S3, exportSink.Gcs)
+	}
+}
@@ -0,0 +1,189 @@
+package cloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+type MetricsEndpointService interface {
+	DescribeMetricsEndpoint(ctx context.Context) (*cloud.CloudMetricsEndpointObservation, error)
+
+	CreateMetricsEndpoint(ctx context.Context, metricsEndpoint *cloud.CloudMetricsEndpointParameters) (string, error)
+	UpdateMetricsEndpoint(ctx context.Context, metricsEndpoint *cloud.CloudMetricsEndpointParameters) (string, error)
+	DeleteMetricsEndpoint(ctx context.Context) error
+
+	MapToMetricsEndpointCompare(metricsEndpoint interface{}) (*MetricsEndpointCompare, error)
+
+	AsyncOperationService
+
+	Close()
+}
+
+type MetricsEndpointCompare struct {
+	AcceptedClientCa string `json:"acceptedClientCa,omitempty"`
+}
+
+type metricsSpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// AcceptedClientCa is the base64 encoded PEM CA bundle.
+	AcceptedClientCa string `json:"acceptedClientCa,omitempty"`
+}
+
+type accountSpec struct {
+	Metrics *metricsSpec `json:"metrics,omitempty"`
+}
+
+type accountMetrics struct {
+	Uri string `json:"uri"`
+}
+
+type account struct {
+	Id              string          `json:"id"`
+	ResourceVersion string          `json:"resourceVersion"`
+	Spec            accountSpec     `json:"spec"`
+	State           string          `json:"state"`
+	Metrics         *accountMetrics `json:"metrics,omitempty"`
+}
+
+type getAccountResponse struct {
+	Account *account `json:"account"`
+}
+
+type updateAccountRequest struct {
+	Spec             accountSpec `json:"spec"`
+	ResourceVersion  string      `json:"resourceVersion"`
+	AsyncOperationId string      `json:"asyncOperationId,omitempty"`
+}
+
+func (s *CloudServiceImpl) MapToMetricsEndpointCompare(metricsEndpoint interface{}) (*MetricsEndpointCompare, error) {
+	metricsEndpointJson, err := json.Marshal(metricsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var metricsEndpointCompare = MetricsEndpointCompare{}
+	err = json.Unmarshal(metricsEndpointJson, &metricsEndpointCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	// Temporal Cloud might return the CA bundle with different trailing whitespace
+	metricsEndpointCompare.AcceptedClientCa = strings.TrimSpace(metricsEndpointCompare.AcceptedClientCa)
+	return &metricsEndpointCompare, nil
+}
+
+// DescribeMetricsEndpoint returns the metrics endpoint of the account or nil
+// if it is not enabled.
+func (s *CloudServiceImpl) DescribeMetricsEndpoint(ctx context.Context) (*cloud.CloudMetricsEndpointObservation, error) {
+	account, err := s.getAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Spec.Metrics == nil || !account.Spec.Metrics.Enabled {
+		s.logger.Debug("Metrics endpoint of account '" + account.Id + "' is not enabled")
+		return nil, nil
+	}
+
+	acceptedClientCa, err := base64.StdEncoding.DecodeString(account.Spec.Metrics.AcceptedClientCa)
+	if err != nil {
+		return nil, err
+	}
+
+	observation := &cloud.CloudMetricsEndpointObservation{
+		AccountId:        account.Id,
+		AcceptedClientCa: string(acceptedClientCa),
+		State:            normalizeState(account.State),
+		ResourceVersion:  account.ResourceVersion,
+	}
+
+	if account.Metrics != nil {
+		observation.Uri = account.Metrics.Uri
+	}
+
+	return observation, nil
+}
+
+// CreateMetricsEndpoint enables the metrics endpoint of the account and
+// returns the account id.
+func (s *CloudServiceImpl) CreateMetricsEndpoint(ctx context.Context, metricsEndpoint *cloud.CloudMetricsEndpointParameters) (string, error) {
+	account, err := s.getAccount(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.updateMetrics(ctx, account, mapToMetricsSpec(metricsEndpoint))
+	if err != nil {
+		return "", err
+	}
+
+	return account.Id, nil
+}
+
+// UpdateMetricsEndpoint changes the accepted client CA of the metrics
+// endpoint and returns the id of the asynchronous operation.
+func (s *CloudServiceImpl) UpdateMetricsEndpoint(ctx context.Context, metricsEndpoint *cloud.CloudMetricsEndpointParameters) (string, error) {
+	account, err := s.getAccount(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return s.updateMetrics(ctx, account, mapToMetricsSpec(metricsEndpoint))
+}
+
+// DeleteMetricsEndpoint disables the metrics endpoint of the account.
+func (s *CloudServiceImpl) DeleteMetricsEndpoint(ctx context.Context) error {
+	account, err := s.getAccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	if account.Spec.Metrics == nil || !account.Spec.Metrics.Enabled {
+		s.logger.Debug("Metrics endpoint of account '" + account.Id + "' is already disabled")
+		return nil
+	}
+
+	_, err = s.updateMetrics(ctx, account, &metricsSpec{Enabled: false})
+	return err
+}
+
+func (s *CloudServiceImpl) getAccount(ctx context.Context) (*account, error) {
+	response := &getAccountResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/account", nil, nil, response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Account == nil {
+		return nil, &APIError{StatusCode: http.StatusNotFound, Message: "account not found"}
+	}
+
+	return response.Account, nil
+}
+
+func (s *CloudServiceImpl) updateMetrics(ctx context.Context, current *account, metrics *metricsSpec) (string, error) {
+	spec := current.Spec
+	spec.Metrics = metrics
+
+	request := &updateAccountRequest{
+		Spec:             spec,
+		ResourceVersion:  current.ResourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/account", nil, request, request.AsyncOperationId)
+}
+
+func mapToMetricsSpec(metricsEndpoint *cloud.CloudMetricsEndpointParameters) *metricsSpec {
+	return &metricsSpec{
+		Enabled:          true,
+		AcceptedClientCa: base64.StdEncoding.EncodeToString([]byte(metricsEndpoint.AcceptedClientCa)),
+	}
+}
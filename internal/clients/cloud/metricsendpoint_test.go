@@ -0,0 +1,87 @@
+package cloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestDescribeMetricsEndpoint(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/cloud/account" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"account":{
+			"id":"acct",
+			"resourceVersion":"rv1",
+			"state":"RESOURCE_STATE_ACTIVE",
+			"spec":{"metrics":{"enabled":true,"acceptedClientCa":"` + base64.StdEncoding.EncodeToString([]byte(testCa)) + `"}},
+			"metrics":{"uri":"https://acct.tmprl.cloud/prometheus"}
+		}}`))
+	})
+
+	metricsEndpoint, err := service.DescribeMetricsEndpoint(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if metricsEndpoint == nil {
+		t.Fatal("expected metrics endpoint")
+	}
+
+	if metricsEndpoint.AccountId != "acct" || metricsEndpoint.Uri != "https://acct.tmprl.cloud/prometheus" || metricsEndpoint.AcceptedClientCa != testCa || metricsEndpoint.State != "active" {
+		t.Fatalf("unexpected metrics endpoint %v", metricsEndpoint)
+	}
+}
+
+func TestDescribeMetricsEndpointDisabled(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"account":{"id":"acct","resourceVersion":"rv1","state":"RESOURCE_STATE_ACTIVE","spec":{}}}`))
+	})
+
+	metricsEndpoint, err := service.DescribeMetricsEndpoint(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if metricsEndpoint != nil {
+		t.Fatalf("expected no metrics endpoint, got %v", metricsEndpoint)
+	}
+}
+
+func TestDeleteMetricsEndpoint(t *testing.T) {
+	updated := false
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"account":{"id":"acct","resourceVersion":"rv1","state":"RESOURCE_STATE_ACTIVE","spec":{"metrics":{"enabled":true}}}}`))
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/cloud/account" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		request := &updateAccountRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Error(err)
+		}
+		if request.ResourceVersion != "rv1" || request.Spec.Metrics == nil || request.Spec.Metrics.Enabled {
+			t.Errorf("expected disabled metrics, got %v", request)
+		}
+
+		updated = true
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := service.DeleteMetricsEndpoint(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updated {
+		t.Fatal("expected the account to be updated")
+	}
+}
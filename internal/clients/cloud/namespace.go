@@ -0,0 +1,374 @@
+package cloud
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/clients/compare"
+)
+
+const (
+	authMethodApiKey = "ApiKey"
+	authMethodMtls   = "Mtls"
+)
+
+type NamespaceService interface {
+	DescribeNamespace(ctx context.Context, namespace string) (*cloud.CloudNamespaceObservation, error)
+
+	CreateNamespace(ctx context.Context, namespace *cloud.CloudNamespaceParameters) (string, error)
+	UpdateNamespace(ctx context.Context, namespace string, resourceVersion string, spec *cloud.CloudNamespaceParameters) (string, error)
+	DeleteNamespace(ctx context.Context, namespace string, resourceVersion string) error
+
+	// AddNamespaceRegion and DeleteNamespaceRegion replicate the namespace to
+	// a region or remove the replica. They return the id of the asynchronous
+	// operation, which applies the change.
+	AddNamespaceRegion(ctx context.Context, namespace string, resourceVersion string, region string) (string, error)
+	DeleteNamespaceRegion(ctx context.Context, namespace string, resourceVersion string, region string) (string, error)
+
+	AsyncOperationService
+
+	MapToNamespaceCompare(namespace interface{}) (*NamespaceCompare, error)
+
+	Close()
+}
+
+type NamespaceCompare struct {
+	Name          string                        `json:"name"`
+	Regions       []string                      `json:"regions,omitempty"`
+	RetentionDays int32                         `json:"retentionDays"`
+	AuthMethod    string                        `json:"authMethod"`
+	MtlsAuth      *cloud.CloudNamespaceMtlsAuth `json:"mtlsAuth,omitempty"`
+
+	CustomSearchAttributes map[string]string `json:"customSearchAttributes,omitempty"`
+}
+
+type namespaceSpec struct {
+	Name          string          `json:"name"`
+	Regions       []string        `json:"regions,omitempty"`
+	RetentionDays int32           `json:"retentionDays,omitempty"`
+	MtlsAuth      *mtlsAuthSpec   `json:"mtlsAuth,omitempty"`
+	ApiKeyAuth    *apiKeyAuthSpec `json:"apiKeyAuth,omitempty"`
+
+	CustomSearchAttributes map[string]string `json:"customSearchAttributes,omitempty"`
+}
+
+type mtlsAuthSpec struct {
+	// AcceptedClientCa is the base64 encoded PEM CA bundle.
+	AcceptedClientCa   string                                  `json:"acceptedClientCa,omitempty"`
+	CertificateFilters []cloud.CloudNamespaceCertificateFilter `json:"certificateFilters,omitempty"`
+	Enabled            bool                                    `json:"enabled,omitempty"`
+}
+
+type apiKeyAuthSpec struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+type namespaceEndpoints struct {
+	WebAddress      string `json:"webAddress,omitempty"`
+	MtlsGrpcAddress string `json:"mtlsGrpcAddress,omitempty"`
+	GrpcAddress     string `json:"grpcAddress,omitempty"`
+}
+
+type namespaceRegionStatus struct {
+	State            string `json:"state"`
+	AsyncOperationId string `json:"asyncOperationId,omitempty"`
+}
+
+type namespace struct {
+	Namespace       string                           `json:"namespace"`
+	ResourceVersion string                           `json:"resourceVersion"`
+	Spec            namespaceSpec                    `json:"spec"`
+	State           string                           `json:"state"`
+	ActiveRegion    string                           `json:"activeRegion,omitempty"`
+	RegionStatus    map[string]namespaceRegionStatus `json:"regionStatus,omitempty"`
+	Endpoints       namespaceEndpoints               `json:"endpoints"`
+}
+
+type getNamespaceResponse struct {
+	Namespace *namespace `json:"namespace"`
+}
+
+type createNamespaceRequest struct {
+	Spec             namespaceSpec `json:"spec"`
+	AsyncOperationId string        `json:"asyncOperationId,omitempty"`
+}
+
+type createNamespaceResponse struct {
+	Namespace string `json:"namespace"`
+}
+
+type updateNamespaceRequest struct {
+	Spec             namespaceSpec `json:"spec"`
+	ResourceVersion  string        `json:"resourceVersion"`
+	AsyncOperationId string        `json:"asyncOperationId,omitempty"`
+}
+
+type addNamespaceRegionRequest struct {
+	Region           string `json:"region"`
+	ResourceVersion  string `json:"resourceVersion"`
+	AsyncOperationId string `json:"asyncOperationId,omitempty"`
+}
+
+// MapToNamespaceCompare maps the parameters or the observation of a namespace
+// field by field and normalizes the fields, which Temporal Cloud might
+// represent differently than the spec.
+func (s *CloudServiceImpl) MapToNamespaceCompare(namespace interface{}) (*NamespaceCompare, error) {
+	var namespaceCompare NamespaceCompare
+
+	switch n := namespace.(type) {
+	case *cloud.CloudNamespaceParameters:
+		namespaceCompare = NamespaceCompare{
+			Name:                   n.Name,
+			Regions:                n.Regions,
+			RetentionDays:          n.RetentionDays,
+			AuthMethod:             n.AuthMethod,
+			MtlsAuth:               n.MtlsAuth,
+			CustomSearchAttributes: n.CustomSearchAttributes,
+		}
+	case *cloud.CloudNamespaceObservation:
+		namespaceCompare = NamespaceCompare{
+			Name:                   n.Name,
+			Regions:                n.Regions,
+			RetentionDays:          n.RetentionDays,
+			AuthMethod:             n.AuthMethod,
+			MtlsAuth:               n.MtlsAuth,
+			CustomSearchAttributes: n.CustomSearchAttributes,
+		}
+	default:
+		return nil, fmt.Errorf("cannot compare %T as namespace", namespace)
+	}
+
+	return &NamespaceCompare{
+		Name: namespaceCompare.Name,
+
+		// The order of the regions is irrelevant, because the primary region
+		// can not be changed
+		Regions: compare.Strings(namespaceCompare.Regions),
+
+		RetentionDays:          namespaceCompare.RetentionDays,
+		AuthMethod:             compare.Enum(namespaceCompare.AuthMethod, authMethodApiKey, authMethodMtls),
+		MtlsAuth:               normalizeMtlsAuth(namespaceCompare.MtlsAuth),
+		CustomSearchAttributes: compare.Map(namespaceCompare.CustomSearchAttributes),
+	}, nil
+}
+
+func normalizeMtlsAuth(mtlsAuth *cloud.CloudNamespaceMtlsAuth) *cloud.CloudNamespaceMtlsAuth {
+	if mtlsAuth == nil {
+		return nil
+	}
+
+	normalized := mtlsAuth.DeepCopy()
+
+	// The server might reformat the CA bundle
+	normalized.AcceptedClientCa = strings.TrimSpace(normalized.AcceptedClientCa)
+	if len(normalized.CertificateFilters) == 0 {
+		normalized.CertificateFilters = nil
+	}
+	return normalized
+}
+
+// DescribeNamespace returns the namespace with the given id, i.e. the name
+// with the account id suffix, or nil if it does not exist.
+func (s *CloudServiceImpl) DescribeNamespace(ctx context.Context, namespace string) (*cloud.CloudNamespaceObservation, error) {
+	response := &getNamespaceResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/namespaces/"+url.PathEscape(namespace), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("Namespace '" + namespace + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Namespace == nil || normalizeState(response.Namespace.State) == "deleted" {
+		return nil, nil
+	}
+
+	return mapNamespace(response.Namespace)
+}
+
+// CreateNamespace creates the namespace and returns its id, i.e. the name with
+// the account id suffix.
+func (s *CloudServiceImpl) CreateNamespace(ctx context.Context, namespace *cloud.CloudNamespaceParameters) (string, error) {
+	request := &createNamespaceRequest{
+		Spec:             mapToNamespaceSpec(namespace),
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	response := &createNamespaceResponse{}
+	err := s.do(ctx, http.MethodPost, "/cloud/namespaces", nil, request, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Namespace, nil
+}
+
+// UpdateNamespace changes the namespace and returns the id of the
+// asynchronous operation.
+func (s *CloudServiceImpl) UpdateNamespace(ctx context.Context, namespace string, resourceVersion string, spec *cloud.CloudNamespaceParameters) (string, error) {
+	request := &updateNamespaceRequest{
+		Spec:             mapToNamespaceSpec(spec),
+		ResourceVersion:  resourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/namespaces/"+url.PathEscape(namespace), nil, request, request.AsyncOperationId)
+}
+
+func (s *CloudServiceImpl) DeleteNamespace(ctx context.Context, namespace string, resourceVersion string) error {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	err := s.do(ctx, http.MethodDelete, "/cloud/namespaces/"+url.PathEscape(namespace), query, nil, nil)
+
+	if IsNotFound(err) {
+		s.logger.Debug("Namespace '" + namespace + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+func (s *CloudServiceImpl) AddNamespaceRegion(ctx context.Context, namespace string, resourceVersion string, region string) (string, error) {
+	request := &addNamespaceRegionRequest{
+		Region:           region,
+		ResourceVersion:  resourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/namespaces/"+url.PathEscape(namespace)+"/add-region", nil, request, request.AsyncOperationId)
+}
+
+func (s *CloudServiceImpl) DeleteNamespaceRegion(ctx context.Context, namespace string, resourceVersion string, region string) (string, error) {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	return s.startAsyncOperation(ctx, http.MethodDelete, "/cloud/namespaces/"+url.PathEscape(namespace)+"/regions/"+url.PathEscape(region), query, nil, query.Get("asyncOperationId"))
+}
+
+func mapToNamespaceSpec(namespace *cloud.CloudNamespaceParameters) namespaceSpec {
+	spec := namespaceSpec{
+		Name:          namespace.Name,
+		Regions:       namespace.Regions,
+		RetentionDays: namespace.RetentionDays,
+	}
+
+	for name, attributeType := range namespace.CustomSearchAttributes {
+		if spec.CustomSearchAttributes == nil {
+			spec.CustomSearchAttributes = map[string]string{}
+		}
+		spec.CustomSearchAttributes[name] = toSearchAttributeApiType(attributeType)
+	}
+
+	switch namespace.AuthMethod {
+	case authMethodMtls:
+		spec.MtlsAuth = &mtlsAuthSpec{Enabled: true}
+		if namespace.MtlsAuth != nil {
+			spec.MtlsAuth.AcceptedClientCa = base64.StdEncoding.EncodeToString([]byte(namespace.MtlsAuth.AcceptedClientCa))
+			spec.MtlsAuth.CertificateFilters = namespace.MtlsAuth.CertificateFilters
+		}
+	default:
+		spec.ApiKeyAuth = &apiKeyAuthSpec{Enabled: true}
+	}
+
+	return spec
+}
+
+func mapNamespace(namespace *namespace) (*cloud.CloudNamespaceObservation, error) {
+	observation := &cloud.CloudNamespaceObservation{
+		Namespace:       namespace.Namespace,
+		Name:            namespace.Spec.Name,
+		Regions:         namespace.Spec.Regions,
+		RetentionDays:   namespace.Spec.RetentionDays,
+		AuthMethod:      authMethodApiKey,
+		State:           normalizeState(namespace.State),
+		ResourceVersion: namespace.ResourceVersion,
+		ActiveRegion:    namespace.ActiveRegion,
+		GrpcAddress:     namespace.Endpoints.GrpcAddress,
+		MtlsGrpcAddress: namespace.Endpoints.MtlsGrpcAddress,
+		WebAddress:      namespace.Endpoints.WebAddress,
+	}
+
+	for name, attributeType := range namespace.Spec.CustomSearchAttributes {
+		if observation.CustomSearchAttributes == nil {
+			observation.CustomSearchAttributes = map[string]string{}
+		}
+		observation.CustomSearchAttributes[name] = fromApiValue(searchAttributeTypes, attributeType, "search_attribute_type_")
+	}
+
+	regions := make([]string, 0, len(namespace.RegionStatus))
+	for region := range namespace.RegionStatus {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		observation.RegionStatus = append(observation.RegionStatus, cloud.CloudNamespaceRegionStatus{
+			Region: region,
+			State:  normalizeState(namespace.RegionStatus[region].State),
+		})
+	}
+
+	mtlsAuth := namespace.Spec.MtlsAuth
+	apiKeyAuthEnabled := namespace.Spec.ApiKeyAuth != nil && namespace.Spec.ApiKeyAuth.Enabled
+	if mtlsAuth != nil && mtlsAuth.AcceptedClientCa != "" && !apiKeyAuthEnabled {
+		acceptedClientCa, err := base64.StdEncoding.DecodeString(mtlsAuth.AcceptedClientCa)
+		if err != nil {
+			return nil, err
+		}
+
+		observation.AuthMethod = authMethodMtls
+		observation.MtlsAuth = &cloud.CloudNamespaceMtlsAuth{
+			AcceptedClientCa:   string(acceptedClientCa),
+			CertificateFilters: mtlsAuth.CertificateFilters,
+		}
+	}
+
+	return observation, nil
+}
+
+// searchAttributeTypes maps the search attribute types of the Temporal Cloud
+// Operations API to the types of the CloudNamespace.
+var searchAttributeTypes = map[string]string{
+	"bool":        "Bool",
+	"datetime":    "Datetime",
+	"double":      "Double",
+	"int":         "Int",
+	"keyword":     "Keyword",
+	"keywordlist": "KeywordList",
+	"text":        "Text",
+}
+
+// toSearchAttributeApiType maps a search attribute type of the CloudNamespace
+// to the type of the Temporal Cloud Operations API, e.g. KeywordList to
+// keyword_list.
+func toSearchAttributeApiType(attributeType string) string {
+	if attributeType == "KeywordList" {
+		return "keyword_list"
+	}
+	return toApiValue(attributeType)
+}
+
+// normalizeState returns the state in lower case without the enum prefix, so
+// that states of all API versions are reported alike, e.g.
+// NAMESPACE_STATE_ACTIVE and active are both reported as active.
+func normalizeState(state string) string {
+	state = strings.ToLower(state)
+	for _, prefix := range []string{"namespace_state_", "namespace_region_status_state_", "async_operation_state_", "resource_state_"} {
+		state = strings.TrimPrefix(state, prefix)
+	}
+	return state
+}
@@ -0,0 +1,274 @@
+package cloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+const testCa = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+func createCloudService(t *testing.T, handler http.HandlerFunc) *CloudServiceImpl {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config, err := json.Marshal(CloudServiceConfig{
+		APIKey:   "test-key",
+		Endpoint: server.URL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service, err := NewCloudService(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(service.Close)
+	return service
+}
+
+func TestDescribeNamespace(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/cloud/namespaces/test001.acct" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("unexpected Authorization header '%s'", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get(headerAPIVersion) != defaultAPIVersion {
+			t.Errorf("unexpected api version header '%s'", r.Header.Get(headerAPIVersion))
+		}
+
+		_, _ = w.Write([]byte(`{"namespace":{
+			"namespace":"test001.acct",
+			"resourceVersion":"rv1",
+			"state":"NAMESPACE_STATE_ACTIVE",
+			"activeRegion":"aws-eu-central-1",
+			"spec":{"name":"test001","regions":["aws-eu-central-1"],"retentionDays":7,
+				"mtlsAuth":{"acceptedClientCa":"` + base64.StdEncoding.EncodeToString([]byte(testCa)) + `","enabled":true},
+				"customSearchAttributes":{"CustomerId":"keyword","Tags":"SEARCH_ATTRIBUTE_TYPE_KEYWORD_LIST"}},
+			"endpoints":{"grpcAddress":"test001.acct.tmprl.cloud:7233","webAddress":"https://cloud.temporal.io/namespaces/test001.acct"}
+		}}`))
+	})
+
+	namespace, err := service.DescribeNamespace(context.Background(), "test001.acct")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &cloud.CloudNamespaceObservation{
+		Namespace:     "test001.acct",
+		Name:          "test001",
+		Regions:       []string{"aws-eu-central-1"},
+		RetentionDays: 7,
+		AuthMethod:    authMethodMtls,
+		MtlsAuth:      &cloud.CloudNamespaceMtlsAuth{AcceptedClientCa: testCa},
+		CustomSearchAttributes: map[string]string{
+			"CustomerId": "Keyword",
+			"Tags":       "KeywordList",
+		},
+		State:           "active",
+		ResourceVersion: "rv1",
+		ActiveRegion:    "aws-eu-central-1",
+		GrpcAddress:     "test001.acct.tmprl.cloud:7233",
+		WebAddress:      "https://cloud.temporal.io/namespaces/test001.acct",
+	}
+
+	expectedJson, _ := json.Marshal(expected)
+	namespaceJson, _ := json.Marshal(namespace)
+	if string(expectedJson) != string(namespaceJson) {
+		t.Fatalf("expected %s, got %s", expectedJson, namespaceJson)
+	}
+}
+
+func TestDescribeNamespaceNotFound(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":5,"message":"namespace not found"}`))
+	})
+
+	namespace, err := service.DescribeNamespace(context.Background(), "test002.acct")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if namespace != nil {
+		t.Fatalf("expected no namespace, got %v", namespace)
+	}
+}
+
+func TestCreateNamespace(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/cloud/namespaces" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		request := &createNamespaceRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Error(err)
+		}
+		if request.Spec.ApiKeyAuth == nil || !request.Spec.ApiKeyAuth.Enabled || request.Spec.MtlsAuth != nil {
+			t.Errorf("expected api key auth, got %v", request.Spec)
+		}
+		if request.Spec.CustomSearchAttributes["Tags"] != "keyword_list" {
+			t.Errorf("expected search attribute type 'keyword_list', got %v", request.Spec.CustomSearchAttributes)
+		}
+		if request.AsyncOperationId == "" {
+			t.Error("expected asyncOperationId")
+		}
+
+		_, _ = w.Write([]byte(`{"namespace":"test003.acct","asyncOperation":{"id":"` + request.AsyncOperationId + `"}}`))
+	})
+
+	namespace, err := service.CreateNamespace(context.Background(), &cloud.CloudNamespaceParameters{
+		Name:          "test003",
+		Regions:       []string{"aws-eu-central-1"},
+		RetentionDays: 7,
+		AuthMethod:    authMethodApiKey,
+		CustomSearchAttributes: map[string]string{
+			"Tags": "KeywordList",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if namespace != "test003.acct" {
+		t.Fatalf("expected namespace 'test003.acct', got '%s'", namespace)
+	}
+}
+
+func TestRequestError(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":3,"message":"invalid retention"}`))
+	})
+
+	_, err := service.UpdateNamespace(context.Background(), "test004.acct", "rv1", &cloud.CloudNamespaceParameters{Name: "test004"})
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "invalid retention" {
+		t.Fatalf("unexpected error %v", apiErr)
+	}
+}
+
+func TestAddNamespaceRegion(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /cloud/namespaces/test005.acct/add-region":
+			request := &addNamespaceRegionRequest{}
+			if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+				t.Error(err)
+			}
+			if request.Region != "aws-eu-west-1" || request.ResourceVersion != "rv1" {
+				t.Errorf("unexpected request %v", request)
+			}
+			_, _ = w.Write([]byte(`{"asyncOperation":{"id":"op1","state":"pending"}}`))
+		case "GET /cloud/operations/op1":
+			_, _ = w.Write([]byte(`{"asyncOperation":{"id":"op1","state":"ASYNC_OPERATION_STATE_FULFILLED"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	asyncOperationId, err := service.AddNamespaceRegion(context.Background(), "test005.acct", "rv1", "aws-eu-west-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if asyncOperationId != "op1" {
+		t.Fatalf("expected asyncOperationId 'op1', got '%s'", asyncOperationId)
+	}
+
+	operation, err := service.GetAsyncOperation(context.Background(), asyncOperationId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !operation.IsDone() || operation.State != AsyncOperationStateFulfilled {
+		t.Fatalf("expected fulfilled operation, got %v", operation)
+	}
+}
+
+func TestCompareNamespaceDrift(t *testing.T) {
+	service := &CloudServiceImpl{}
+
+	cases := map[string]struct {
+		spec     *cloud.CloudNamespaceParameters
+		observed *cloud.CloudNamespaceObservation
+		drift    bool
+	}{
+		"Equal": {
+			spec:     &cloud.CloudNamespaceParameters{Name: "test", Regions: []string{"aws-us-east-1"}, RetentionDays: 30, AuthMethod: authMethodApiKey},
+			observed: &cloud.CloudNamespaceObservation{Name: "test", Regions: []string{"aws-us-east-1"}, RetentionDays: 30, AuthMethod: authMethodApiKey, State: "Active"},
+		},
+		"RegionsInOtherOrder": {
+			spec:     &cloud.CloudNamespaceParameters{Name: "test", Regions: []string{"aws-us-west-2", "aws-us-east-1"}, RetentionDays: 30, AuthMethod: authMethodApiKey},
+			observed: &cloud.CloudNamespaceObservation{Name: "test", Regions: []string{"aws-us-east-1", "aws-us-west-2"}, RetentionDays: 30, AuthMethod: authMethodApiKey},
+		},
+		"AuthMethodCase": {
+			spec:     &cloud.CloudNamespaceParameters{Name: "test", RetentionDays: 30, AuthMethod: "mtls", MtlsAuth: &cloud.CloudNamespaceMtlsAuth{AcceptedClientCa: testCa}},
+			observed: &cloud.CloudNamespaceObservation{Name: "test", RetentionDays: 30, AuthMethod: authMethodMtls, MtlsAuth: &cloud.CloudNamespaceMtlsAuth{AcceptedClientCa: testCa}},
+		},
+		"ReformattedCa": {
+			spec:     &cloud.CloudNamespaceParameters{Name: "test", RetentionDays: 30, AuthMethod: authMethodMtls, MtlsAuth: &cloud.CloudNamespaceMtlsAuth{AcceptedClientCa: testCa + "\n"}},
+			observed: &cloud.CloudNamespaceObservation{Name: "test", RetentionDays: 30, AuthMethod: authMethodMtls, MtlsAuth: &cloud.CloudNamespaceMtlsAuth{AcceptedClientCa: testCa}},
+		},
+		"RetentionChanged": {
+			spec:     &cloud.CloudNamespaceParameters{Name: "test", RetentionDays: 7, AuthMethod: authMethodApiKey},
+			observed: &cloud.CloudNamespaceObservation{Name: "test", RetentionDays: 30, AuthMethod: authMethodApiKey},
+			drift:    true,
+		},
+		"SearchAttributeAdded": {
+			spec:     &cloud.CloudNamespaceParameters{Name: "test", RetentionDays: 30, AuthMethod: authMethodApiKey, CustomSearchAttributes: map[string]string{"CustomerId": "Keyword"}},
+			observed: &cloud.CloudNamespaceObservation{Name: "test", RetentionDays: 30, AuthMethod: authMethodApiKey, CustomSearchAttributes: map[string]string{}},
+			drift:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := service.MapToNamespaceCompare(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			observed, err := service.MapToNamespaceCompare(tc.observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if drift := !reflect.DeepEqual(spec, observed); drift != tc.drift {
+				t.Fatalf("expected drift %t, got %+v and %+v", tc.drift, spec, observed)
+			}
+		})
+	}
+}
+
+func TestMapToNamespaceCompareKeepsSpec(t *testing.T) {
+	service := &CloudServiceImpl{}
+
+	spec := &cloud.CloudNamespaceParameters{Name: "test", Regions: []string{"b", "a"}, MtlsAuth: &cloud.CloudNamespaceMtlsAuth{AcceptedClientCa: testCa + "\n"}}
+	if _, err := service.MapToNamespaceCompare(spec); err != nil {
+		t.Fatal(err)
+	}
+
+	if spec.Regions[0] != "b" || spec.MtlsAuth.AcceptedClientCa != testCa+"\n" {
+		t.Fatalf("expected spec not to be changed, got %+v", spec)
+	}
+
+	if _, err := service.MapToNamespaceCompare(&cloud.CloudUserParameters{}); err == nil {
+		t.Fatal("expected error for unexpected type")
+	}
+}
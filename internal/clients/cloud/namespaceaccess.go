@@ -0,0 +1,198 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+const (
+	IdentityTypeUser           = "User"
+	IdentityTypeUserGroup      = "UserGroup"
+	IdentityTypeServiceAccount = "ServiceAccount"
+)
+
+// NamespaceAccessService grants users, groups and service accounts
+// permissions on namespaces. The permissions are part of the access of the
+// identity, therefore they are changed by updating the identity.
+type NamespaceAccessService interface {
+	DescribeNamespaceAccess(ctx context.Context, namespaceAccess *cloud.CloudNamespaceAccessParameters) (*cloud.CloudNamespaceAccessObservation, error)
+
+	SetNamespaceAccess(ctx context.Context, namespaceAccess *cloud.CloudNamespaceAccessParameters) (string, error)
+	DeleteNamespaceAccess(ctx context.Context, namespaceAccess *cloud.CloudNamespaceAccessParameters) error
+
+	AsyncOperationService
+
+	Close()
+}
+
+type identity struct {
+	Type string
+	Id   string
+}
+
+// DescribeNamespaceAccess returns the permission of the identity on the
+// namespace or nil if the identity has no permission on it.
+func (s *CloudServiceImpl) DescribeNamespaceAccess(ctx context.Context, namespaceAccess *cloud.CloudNamespaceAccessParameters) (*cloud.CloudNamespaceAccessObservation, error) {
+	namespace, identity, err := mapToNamespaceAccess(namespaceAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	var permission string
+	_, err = s.changeAccess(ctx, identity, func(access *access) bool {
+		if current, ok := access.NamespaceAccesses[namespace]; ok {
+			permission = fromApiValue(namespacePermissions, current.Permission, "permission_")
+		}
+		return false
+	})
+
+	if IsNotFound(err) {
+		s.logger.Debug(identity.Type + " '" + identity.Id + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil || permission == "" {
+		return nil, err
+	}
+
+	return &cloud.CloudNamespaceAccessObservation{
+		Namespace:    namespace,
+		IdentityType: identity.Type,
+		IdentityId:   identity.Id,
+		Permission:   permission,
+	}, nil
+}
+
+// SetNamespaceAccess grants the identity the permission on the namespace or
+// changes its current permission. It returns the id of the asynchronous
+// operation.
+func (s *CloudServiceImpl) SetNamespaceAccess(ctx context.Context, namespaceAccess *cloud.CloudNamespaceAccessParameters) (string, error) {
+	namespace, identity, err := mapToNamespaceAccess(namespaceAccess)
+	if err != nil {
+		return "", err
+	}
+
+	return s.changeAccess(ctx, identity, func(access *access) bool {
+		namespaceAccesses := map[string]namespaceAccessEntry{}
+		for ns, current := range access.NamespaceAccesses {
+			namespaceAccesses[ns] = current
+		}
+		namespaceAccesses[namespace] = namespaceAccessEntry{Permission: toApiValue(namespaceAccess.Permission)}
+		access.NamespaceAccesses = namespaceAccesses
+		return true
+	})
+}
+
+// DeleteNamespaceAccess revokes the permission of the identity on the
+// namespace.
+func (s *CloudServiceImpl) DeleteNamespaceAccess(ctx context.Context, namespaceAccess *cloud.CloudNamespaceAccessParameters) error {
+	namespace, identity, err := mapToNamespaceAccess(namespaceAccess)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.changeAccess(ctx, identity, func(access *access) bool {
+		if _, ok := access.NamespaceAccesses[namespace]; !ok {
+			return false
+		}
+
+		namespaceAccesses := map[string]namespaceAccessEntry{}
+		for ns, current := range access.NamespaceAccesses {
+			if ns != namespace {
+				namespaceAccesses[ns] = current
+			}
+		}
+		access.NamespaceAccesses = namespaceAccesses
+		return true
+	})
+
+	if IsNotFound(err) {
+		s.logger.Debug(identity.Type + " '" + identity.Id + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+// changeAccess reads the access of the identity and passes it to change. If
+// change returns true, the changed access is written back and the id of the
+// asynchronous operation is returned.
+func (s *CloudServiceImpl) changeAccess(ctx context.Context, identity identity, change func(access *access) bool) (string, error) {
+	notFound := &APIError{StatusCode: http.StatusNotFound, Message: identity.Type + " '" + identity.Id + "' not found"}
+
+	switch identity.Type {
+	case IdentityTypeUser:
+		current, err := s.getUser(ctx, identity.Id)
+		if err != nil {
+			return "", err
+		}
+		if current == nil {
+			return "", notFound
+		}
+
+		spec := current.Spec
+		if !change(&spec.Access) {
+			return "", nil
+		}
+		return s.updateUser(ctx, current, spec)
+
+	case IdentityTypeUserGroup:
+		current, err := s.getUserGroup(ctx, identity.Id)
+		if err != nil {
+			return "", err
+		}
+		if current == nil {
+			return "", notFound
+		}
+
+		spec := current.Spec
+		if !change(&spec.Access) {
+			return "", nil
+		}
+		return s.updateUserGroup(ctx, current, spec)
+
+	case IdentityTypeServiceAccount:
+		current, err := s.getServiceAccount(ctx, identity.Id)
+		if err != nil {
+			return "", err
+		}
+		if current == nil {
+			return "", notFound
+		}
+
+		spec := current.Spec
+		if !change(&spec.Access) {
+			return "", nil
+		}
+		return s.updateServiceAccount(ctx, current, spec)
+	}
+
+	return "", errors.New("unknown identity type '" + identity.Type + "'")
+}
+
+func mapToNamespaceAccess(namespaceAccess *cloud.CloudNamespaceAccessParameters) (string, identity, error) {
+	if namespaceAccess.Namespace == nil {
+		return "", identity{}, errors.New("namespace not set")
+	}
+
+	var identities []identity
+	if namespaceAccess.UserId != nil {
+		identities = append(identities, identity{Type: IdentityTypeUser, Id: *namespaceAccess.UserId})
+	}
+	if namespaceAccess.UserGroupId != nil {
+		identities = append(identities, identity{Type: IdentityTypeUserGroup, Id: *namespaceAccess.UserGroupId})
+	}
+	if namespaceAccess.ServiceAccountId != nil {
+		identities = append(identities, identity{Type: IdentityTypeServiceAccount, Id: *namespaceAccess.ServiceAccountId})
+	}
+
+	if len(identities) != 1 {
+		return "", identity{}, errors.New("exactly one of a user, a user group or a service account is required")
+	}
+
+	return *namespaceAccess.Namespace, identities[0], nil
+}
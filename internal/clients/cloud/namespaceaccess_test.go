@@ -0,0 +1,76 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+func TestSetNamespaceAccess(t *testing.T) {
+	updated := false
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /cloud/service-accounts/sa1":
+			_, _ = w.Write([]byte(`{"serviceAccount":{"id":"sa1","resourceVersion":"rv1","state":"active",
+				"spec":{"name":"worker","access":{"accountAccess":{"role":"read"},
+					"namespaceAccesses":{"ns1.acct":{"permission":"read"}}}}}}`))
+		case "POST /cloud/service-accounts/sa1":
+			request := &updateServiceAccountRequest{}
+			if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+				t.Error(err)
+			}
+
+			namespaceAccesses := request.Spec.Access.NamespaceAccesses
+			if namespaceAccesses["ns1.acct"].Permission != "read" || namespaceAccesses["ns2.acct"].Permission != "write" {
+				t.Errorf("unexpected namespace accesses %v", namespaceAccesses)
+			}
+			if request.Spec.Access.AccountAccess.Role != "read" || request.ResourceVersion != "rv1" {
+				t.Errorf("unexpected request %v", request)
+			}
+			updated = true
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	namespace := "ns2.acct"
+	serviceAccountId := "sa1"
+	_, err := service.SetNamespaceAccess(context.Background(), &cloud.CloudNamespaceAccessParameters{
+		Namespace:        &namespace,
+		ServiceAccountId: &serviceAccountId,
+		Permission:       "Write",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updated {
+		t.Fatal("service account was not updated")
+	}
+}
+
+func TestDescribeNamespaceAccessWithoutPermission(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"user":{"id":"user1","resourceVersion":"rv1","state":"active",
+			"spec":{"email":"test@test.local","access":{"accountAccess":{"role":"read"}}}}}`))
+	})
+
+	namespace := "ns1.acct"
+	userId := "user1"
+	namespaceAccess, err := service.DescribeNamespaceAccess(context.Background(), &cloud.CloudNamespaceAccessParameters{
+		Namespace:  &namespace,
+		UserId:     &userId,
+		Permission: "Read",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if namespaceAccess != nil {
+		t.Fatalf("expected no namespace access, got %v", namespaceAccess)
+	}
+}
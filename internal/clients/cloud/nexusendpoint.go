@@ -0,0 +1,279 @@
+package cloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+const payloadEncodingJson = "json/plain"
+
+type NexusEndpointService interface {
+	DescribeNexusEndpoint(ctx context.Context, endpointId string) (*cloud.CloudNexusEndpointObservation, error)
+
+	CreateNexusEndpoint(ctx context.Context, endpoint *cloud.CloudNexusEndpointParameters) (string, error)
+	UpdateNexusEndpoint(ctx context.Context, endpointId string, resourceVersion string, endpoint *cloud.CloudNexusEndpointParameters) (string, error)
+	DeleteNexusEndpoint(ctx context.Context, endpointId string, resourceVersion string) error
+
+	MapToNexusEndpointCompare(endpoint interface{}) (*NexusEndpointCompare, error)
+
+	AsyncOperationService
+
+	Close()
+}
+
+type NexusEndpointCompare struct {
+	Name                    string   `json:"name"`
+	Description             string   `json:"description,omitempty"`
+	TargetNamespace         string   `json:"targetNamespace"`
+	TargetTaskQueue         string   `json:"targetTaskQueue"`
+	AllowedCallerNamespaces []string `json:"allowedCallerNamespaces,omitempty"`
+}
+
+// payload is a Temporal payload, whose metadata and data are base64 encoded.
+type payload struct {
+	Metadata map[string]string `json:"metadata,omitempty"`
+	Data     string            `json:"data,omitempty"`
+}
+
+type workerTargetSpec struct {
+	NamespaceId string `json:"namespaceId"`
+	TaskQueue   string `json:"taskQueue"`
+}
+
+type endpointTargetSpec struct {
+	WorkerTargetSpec *workerTargetSpec `json:"workerTargetSpec,omitempty"`
+}
+
+type allowedCloudNamespacePolicySpec struct {
+	NamespaceId string `json:"namespaceId"`
+}
+
+type endpointPolicySpec struct {
+	AllowedCloudNamespacePolicySpec *allowedCloudNamespacePolicySpec `json:"allowedCloudNamespacePolicySpec,omitempty"`
+}
+
+type nexusEndpointSpec struct {
+	Name        string               `json:"name"`
+	Description *payload             `json:"description,omitempty"`
+	TargetSpec  endpointTargetSpec   `json:"targetSpec"`
+	PolicySpecs []endpointPolicySpec `json:"policySpecs,omitempty"`
+}
+
+type nexusEndpoint struct {
+	Id              string            `json:"id"`
+	ResourceVersion string            `json:"resourceVersion"`
+	Spec            nexusEndpointSpec `json:"spec"`
+	State           string            `json:"state"`
+}
+
+type getNexusEndpointResponse struct {
+	Endpoint *nexusEndpoint `json:"endpoint"`
+}
+
+type createNexusEndpointRequest struct {
+	Spec             nexusEndpointSpec `json:"spec"`
+	AsyncOperationId string            `json:"asyncOperationId,omitempty"`
+}
+
+type createNexusEndpointResponse struct {
+	EndpointId string `json:"endpointId"`
+}
+
+type updateNexusEndpointRequest struct {
+	Spec             nexusEndpointSpec `json:"spec"`
+	ResourceVersion  string            `json:"resourceVersion"`
+	AsyncOperationId string            `json:"asyncOperationId,omitempty"`
+}
+
+func (s *CloudServiceImpl) MapToNexusEndpointCompare(endpoint interface{}) (*NexusEndpointCompare, error) {
+	endpointJson, err := json.Marshal(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpointCompare = NexusEndpointCompare{}
+	err = json.Unmarshal(endpointJson, &endpointCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order of the allowed caller namespaces is not significant
+	sort.Strings(endpointCompare.AllowedCallerNamespaces)
+	return &endpointCompare, nil
+}
+
+// DescribeNexusEndpoint returns the Nexus endpoint with the given id or nil if
+// it does not exist.
+func (s *CloudServiceImpl) DescribeNexusEndpoint(ctx context.Context, endpointId string) (*cloud.CloudNexusEndpointObservation, error) {
+	response := &getNexusEndpointResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/nexus/endpoints/"+url.PathEscape(endpointId), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("NexusEndpoint '" + endpointId + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := response.Endpoint
+	if endpoint == nil || normalizeState(endpoint.State) == "deleted" {
+		return nil, nil
+	}
+
+	description, err := fromPayload(endpoint.Spec.Description)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode description of NexusEndpoint '"+endpointId+"'")
+	}
+
+	observation := &cloud.CloudNexusEndpointObservation{
+		Id:              endpoint.Id,
+		Name:            endpoint.Spec.Name,
+		Description:     description,
+		State:           normalizeState(endpoint.State),
+		ResourceVersion: endpoint.ResourceVersion,
+	}
+
+	if target := endpoint.Spec.TargetSpec.WorkerTargetSpec; target != nil {
+		observation.TargetNamespace = target.NamespaceId
+		observation.TargetTaskQueue = target.TaskQueue
+	}
+
+	for _, policy := range endpoint.Spec.PolicySpecs {
+		if policy.AllowedCloudNamespacePolicySpec != nil {
+			observation.AllowedCallerNamespaces = append(observation.AllowedCallerNamespaces, policy.AllowedCloudNamespacePolicySpec.NamespaceId)
+		}
+	}
+
+	return observation, nil
+}
+
+// CreateNexusEndpoint creates the Nexus endpoint and returns its id.
+func (s *CloudServiceImpl) CreateNexusEndpoint(ctx context.Context, endpoint *cloud.CloudNexusEndpointParameters) (string, error) {
+	spec, err := mapToNexusEndpointSpec(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	request := &createNexusEndpointRequest{
+		Spec:             spec,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	response := &createNexusEndpointResponse{}
+	err = s.do(ctx, http.MethodPost, "/cloud/nexus/endpoints", nil, request, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.EndpointId, nil
+}
+
+// UpdateNexusEndpoint changes the Nexus endpoint and returns the id of the
+// asynchronous operation.
+func (s *CloudServiceImpl) UpdateNexusEndpoint(ctx context.Context, endpointId string, resourceVersion string, endpoint *cloud.CloudNexusEndpointParameters) (string, error) {
+	spec, err := mapToNexusEndpointSpec(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	request := &updateNexusEndpointRequest{
+		Spec:             spec,
+		ResourceVersion:  resourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/nexus/endpoints/"+url.PathEscape(endpointId), nil, request, request.AsyncOperationId)
+}
+
+func (s *CloudServiceImpl) DeleteNexusEndpoint(ctx context.Context, endpointId string, resourceVersion string) error {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	err := s.do(ctx, http.MethodDelete, "/cloud/nexus/endpoints/"+url.PathEscape(endpointId), query, nil, nil)
+
+	if IsNotFound(err) {
+		s.logger.Debug("NexusEndpoint '" + endpointId + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+func mapToNexusEndpointSpec(endpoint *cloud.CloudNexusEndpointParameters) (nexusEndpointSpec, error) {
+	if endpoint.TargetNamespace == nil {
+		return nexusEndpointSpec{}, errors.New("targetNamespace of NexusEndpoint '" + endpoint.Name + "' is not set")
+	}
+
+	description, err := toPayload(endpoint.Description)
+	if err != nil {
+		return nexusEndpointSpec{}, err
+	}
+
+	spec := nexusEndpointSpec{
+		Name:        endpoint.Name,
+		Description: description,
+		TargetSpec: endpointTargetSpec{
+			WorkerTargetSpec: &workerTargetSpec{
+				NamespaceId: *endpoint.TargetNamespace,
+				TaskQueue:   endpoint.TargetTaskQueue,
+			},
+		},
+	}
+
+	for _, namespace := range endpoint.AllowedCallerNamespaces {
+		spec.PolicySpecs = append(spec.PolicySpecs, endpointPolicySpec{
+			AllowedCloudNamespacePolicySpec: &allowedCloudNamespacePolicySpec{NamespaceId: namespace},
+		})
+	}
+
+	return spec, nil
+}
+
+// toPayload encodes the text as json payload, which is how Temporal Cloud
+// stores the description of a Nexus endpoint.
+func toPayload(text string) (*payload, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payload{
+		Metadata: map[string]string{"encoding": base64.StdEncoding.EncodeToString([]byte(payloadEncodingJson))},
+		Data:     base64.StdEncoding.EncodeToString(data),
+	}, nil
+}
+
+func fromPayload(p *payload) (string, error) {
+	if p == nil || p.Data == "" {
+		return "", nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(p.Data)
+	if err != nil {
+		return "", err
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		// The description was not set as json, e.g. through the UI
+		return string(data), nil
+	}
+
+	return text, nil
+}
@@ -0,0 +1,85 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+func TestCreateAndDescribeNexusEndpoint(t *testing.T) {
+	var created *nexusEndpointSpec
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/cloud/nexus/endpoints":
+			request := &createNexusEndpointRequest{}
+			if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+				t.Error(err)
+			}
+			created = &request.Spec
+			_, _ = w.Write([]byte(`{"endpointId":"ep1","asyncOperation":{"id":"` + request.AsyncOperationId + `"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/cloud/nexus/endpoints/ep1":
+			_ = json.NewEncoder(w).Encode(&getNexusEndpointResponse{Endpoint: &nexusEndpoint{
+				Id:              "ep1",
+				ResourceVersion: "rv1",
+				State:           "RESOURCE_STATE_ACTIVE",
+				Spec:            *created,
+			}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	targetNamespace := "target.acct"
+	endpointId, err := service.CreateNexusEndpoint(context.Background(), &cloud.CloudNexusEndpointParameters{
+		Name:                    "orders",
+		Description:             "Handles *orders*",
+		TargetNamespace:         &targetNamespace,
+		TargetTaskQueue:         "orders-queue",
+		AllowedCallerNamespaces: []string{"caller1.acct", "caller2.acct"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if endpointId != "ep1" {
+		t.Fatalf("expected endpoint id 'ep1', got '%s'", endpointId)
+	}
+
+	endpoint, err := service.DescribeNexusEndpoint(context.Background(), endpointId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &cloud.CloudNexusEndpointObservation{
+		Id:                      "ep1",
+		Name:                    "orders",
+		Description:             "Handles *orders*",
+		TargetNamespace:         "target.acct",
+		TargetTaskQueue:         "orders-queue",
+		AllowedCallerNamespaces: []string{"caller1.acct", "caller2.acct"},
+		State:                   "active",
+		ResourceVersion:         "rv1",
+	}
+
+	if !reflect.DeepEqual(expected, endpoint) {
+		t.Fatalf("expected %v, got %v", expected, endpoint)
+	}
+}
+
+func TestCreateNexusEndpointWithoutTargetNamespace(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	_, err := service.CreateNexusEndpoint(context.Background(), &cloud.CloudNexusEndpointParameters{
+		Name:            "orders",
+		TargetTaskQueue: "orders-queue",
+	})
+	if err == nil {
+		t.Fatal("expected error, because the target namespace is not set")
+	}
+}
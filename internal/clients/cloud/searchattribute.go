@@ -0,0 +1,97 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/serviceerror"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+// DescribeSearchAttributeByName returns the custom search attribute of the
+// spec of the Temporal Cloud namespace or nil if it does not exist. Temporal
+// Cloud does not serve the OperatorService, therefore the search attributes
+// are managed via the namespace spec.
+func (s *CloudServiceImpl) DescribeSearchAttributeByName(ctx context.Context, namespace string, name string) (*core.SearchAttributeObservation, error) {
+	response, err := s.getSearchAttributeNamespace(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	attributeType, ok := response.Spec.CustomSearchAttributes[name]
+	if !ok {
+		return nil, nil
+	}
+
+	return &core.SearchAttributeObservation{
+		Name:                  name,
+		Type:                  fromApiValue(searchAttributeTypes, attributeType, "search_attribute_type_"),
+		TemporalNamespaceName: namespace,
+		Origin:                temporal.SearchAttributeOriginCustom,
+	}, nil
+}
+
+// CreateSearchAttribute adds the search attribute to the spec of the Temporal
+// Cloud namespace. The namespace applies it asynchronously.
+func (s *CloudServiceImpl) CreateSearchAttribute(ctx context.Context, searchAttribute *core.SearchAttributeParameters) error {
+	namespace := *searchAttribute.TemporalNamespaceName
+	response, err := s.getSearchAttributeNamespace(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	spec := response.Spec
+	customSearchAttributes := map[string]string{}
+	for name, attributeType := range spec.CustomSearchAttributes {
+		customSearchAttributes[name] = attributeType
+	}
+	customSearchAttributes[searchAttribute.Name] = toSearchAttributeApiType(searchAttribute.Type)
+	spec.CustomSearchAttributes = customSearchAttributes
+
+	request := &updateNamespaceRequest{
+		Spec:             spec,
+		ResourceVersion:  response.ResourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	_, err = s.startAsyncOperation(ctx, http.MethodPost, "/cloud/namespaces/"+url.PathEscape(namespace), nil, request, request.AsyncOperationId)
+	return err
+}
+
+// DeleteSearchAttributeByName leaves the search attribute in the Temporal
+// Cloud namespace, because Temporal Cloud does not support removing custom
+// search attributes.
+func (s *CloudServiceImpl) DeleteSearchAttributeByName(ctx context.Context, namespace string, name string) error {
+	s.logger.Debug("Search attribute '" + name + "' of namespace '" + namespace + "' is kept, because Temporal Cloud can not remove search attributes")
+	return nil
+}
+
+func (s *CloudServiceImpl) MapToSearchAttributeCompare(searchAttribute interface{}) (*temporal.SearchAttributeCompare, error) {
+	return temporal.ToSearchAttributeCompare(searchAttribute)
+}
+
+// getSearchAttributeNamespace returns the Temporal Cloud namespace. A missing
+// namespace is reported like the OperatorService does, so that search
+// attributes wait for their namespace in both environments.
+func (s *CloudServiceImpl) getSearchAttributeNamespace(ctx context.Context, namespace string) (*namespace, error) {
+	response := &getNamespaceResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/namespaces/"+url.PathEscape(namespace), nil, nil, response)
+
+	if IsNotFound(err) {
+		return nil, serviceerror.NewNamespaceNotFound(namespace)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Namespace == nil || normalizeState(response.Namespace.State) == "deleted" {
+		return nil, serviceerror.NewNamespaceNotFound(namespace)
+	}
+
+	return response.Namespace, nil
+}
@@ -0,0 +1,104 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+const testSearchAttributeNamespace = `{"namespace":{
+	"namespace":"test010.acct",
+	"resourceVersion":"rv1",
+	"state":"NAMESPACE_STATE_ACTIVE",
+	"spec":{"name":"test010","regions":["aws-eu-central-1"],"retentionDays":7,
+		"apiKeyAuth":{"enabled":true},
+		"customSearchAttributes":{"CustomerId":"SEARCH_ATTRIBUTE_TYPE_KEYWORD"}}
+}}`
+
+func TestDescribeSearchAttributeByName(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testSearchAttributeNamespace))
+	})
+
+	searchAttribute, err := service.DescribeSearchAttributeByName(context.Background(), "test010.acct", "CustomerId")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &core.SearchAttributeObservation{
+		Name:                  "CustomerId",
+		Type:                  "Keyword",
+		TemporalNamespaceName: "test010.acct",
+		Origin:                temporal.SearchAttributeOriginCustom,
+	}
+
+	expectedJson, _ := json.Marshal(expected)
+	searchAttributeJson, _ := json.Marshal(searchAttribute)
+	if string(expectedJson) != string(searchAttributeJson) {
+		t.Fatalf("expected %s, got %s", expectedJson, searchAttributeJson)
+	}
+
+	searchAttribute, err = service.DescribeSearchAttributeByName(context.Background(), "test010.acct", "OrderId")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if searchAttribute != nil {
+		t.Fatalf("expected no search attribute, got %v", searchAttribute)
+	}
+}
+
+func TestDescribeSearchAttributeNamespaceNotFound(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":5,"message":"namespace not found"}`))
+	})
+
+	_, err := service.DescribeSearchAttributeByName(context.Background(), "test011.acct", "CustomerId")
+	if !temporal.IsNamespaceUnavailable(err) {
+		t.Fatalf("expected namespace to be unavailable, got %v", err)
+	}
+}
+
+func TestCreateSearchAttribute(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(testSearchAttributeNamespace))
+			return
+		}
+
+		if r.Method != http.MethodPost || r.URL.Path != "/cloud/namespaces/test010.acct" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		request := &updateNamespaceRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Error(err)
+		}
+		if request.ResourceVersion != "rv1" {
+			t.Errorf("expected resourceVersion 'rv1', got '%s'", request.ResourceVersion)
+		}
+		if request.Spec.ApiKeyAuth == nil || !request.Spec.ApiKeyAuth.Enabled || request.Spec.RetentionDays != 7 {
+			t.Errorf("expected the spec to be kept, got %v", request.Spec)
+		}
+		if request.Spec.CustomSearchAttributes["CustomerId"] != "SEARCH_ATTRIBUTE_TYPE_KEYWORD" || request.Spec.CustomSearchAttributes["Tags"] != "keyword_list" {
+			t.Errorf("expected search attributes CustomerId and Tags, got %v", request.Spec.CustomSearchAttributes)
+		}
+
+		_, _ = w.Write([]byte(`{"asyncOperation":{"id":"` + request.AsyncOperationId + `"}}`))
+	})
+
+	namespace := "test010.acct"
+	err := service.CreateSearchAttribute(context.Background(), &core.SearchAttributeParameters{
+		Name:                  "Tags",
+		Type:                  "KeywordList",
+		TemporalNamespaceName: &namespace,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,350 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc/codes"
+
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+// defaultAPIVersion is the version of the Temporal Cloud Operations API,
+// whose HTTP/JSON resources are implemented by the CloudServiceImpl. It is
+// sent in the temporal-cloud-api-version header, unless the credentials
+// specify another apiVersion.
+const defaultAPIVersion = "v0.3.0"
+
+const (
+	defaultEndpoint = "https://saas-api.tmprl.cloud"
+	requestTimeout  = 30 * time.Second
+
+	defaultMaxRetries = 3
+	defaultRetryDelay = time.Second
+	maxRetryDelay     = 30 * time.Second
+
+	headerAPIVersion = "temporal-cloud-api-version"
+	headerRetryAfter = "Retry-After"
+	headerRequestId  = "X-Request-Id"
+)
+
+// transport is shared by all services, so that the connections to the
+// Temporal Cloud Operations API are reused across ProviderConfigs and
+// reconciles. Its idle connections are closed, when the last service is
+// closed.
+var (
+	transport      = http.DefaultTransport.(*http.Transport).Clone()
+	transportMutex sync.Mutex
+	transportUsers int
+)
+
+func acquireTransport() *http.Transport {
+	transportMutex.Lock()
+	defer transportMutex.Unlock()
+	transportUsers++
+	return transport
+}
+
+func releaseTransport() {
+	transportMutex.Lock()
+	defer transportMutex.Unlock()
+	transportUsers--
+	if transportUsers <= 0 {
+		transportUsers = 0
+		transport.CloseIdleConnections()
+	}
+}
+
+type CloudServiceConfig struct {
+	// APIKey of a user or service account, which is used to authenticate to
+	// the Temporal Cloud Operations API.
+	APIKey string `json:"apiKey"`
+
+	// APIVersion of the Temporal Cloud Operations API.
+	APIVersion string `json:"apiVersion"`
+
+	// Endpoint of the Temporal Cloud Operations API.
+	Endpoint string `json:"endpoint"`
+
+	// MaxRetries of a request, which is rate limited or rejected, because the
+	// Temporal Cloud Operations API is unavailable.
+	MaxRetries *int `json:"maxRetries"`
+}
+
+type CloudServiceImpl struct {
+	httpClient *http.Client
+	endpoint   string
+	apiKey     string
+	apiVersion string
+	maxRetries int
+	retryDelay time.Duration
+	logger     *slog.Logger
+	closeOnce  sync.Once
+}
+
+// APIError is returned, if the Temporal Cloud Operations API rejects a request.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+
+	// retryAfter is the delay, which the server requested before retrying.
+	retryAfter time.Duration
+
+	// requestId is the id of the request, if the server provided one.
+	requestId string
+}
+
+func (e *APIError) Error() string {
+	return "Temporal Cloud Operations API responded with status " + strconv.Itoa(e.StatusCode) + ": " + e.Message
+}
+
+// Terminal returns true, if the request was rejected because of missing
+// permissions, an invalid argument or an unsupported operation, so that
+// retrying it unchanged does not succeed. The gRPC code of the response is
+// preferred over the HTTP status, which is ambiguous for bad requests.
+func (e *APIError) Terminal() bool {
+	switch codes.Code(e.Code) {
+	case codes.PermissionDenied, codes.InvalidArgument, codes.Unimplemented:
+		return true
+	case codes.OK:
+		return e.StatusCode == http.StatusForbidden || e.StatusCode == http.StatusNotImplemented
+	}
+	return false
+}
+
+// ResourceExhausted returns true and the delay, which the server requested
+// before retrying, if the request was rate limited.
+func (e *APIError) ResourceExhausted() (time.Duration, bool) {
+	if e.StatusCode != http.StatusTooManyRequests && codes.Code(e.Code) != codes.ResourceExhausted {
+		return 0, false
+	}
+	return e.retryAfter, true
+}
+
+// RequestDetails returns the gRPC code of the response and the id of the
+// request, if the server provided one.
+func (e *APIError) RequestDetails() (codes.Code, string) {
+	return codes.Code(e.Code), e.requestId
+}
+
+// IsRetryable returns true, if the request was rate limited or the Temporal
+// Cloud Operations API was unavailable, so that the request can be retried.
+func IsRetryable(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode == http.StatusServiceUnavailable)
+}
+
+// IsNotFound returns true, if the Temporal Cloud Operations API responded,
+// that the requested resource does not exist.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+func NewCloudService(configData []byte) (*CloudServiceImpl, error) {
+	var conf = CloudServiceConfig{}
+	err := json.Unmarshal(configData, &conf)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal config data")
+	}
+
+	if conf.APIKey == "" {
+		return nil, errors.New("apiKey is required to connect to Temporal Cloud")
+	}
+
+	if conf.Endpoint == "" {
+		conf.Endpoint = defaultEndpoint
+	}
+
+	if conf.APIVersion == "" {
+		conf.APIVersion = defaultAPIVersion
+	}
+
+	maxRetries := defaultMaxRetries
+	if conf.MaxRetries != nil {
+		maxRetries = *conf.MaxRetries
+	}
+
+	logger := temporal.NewLogger()
+
+	logger.Debug("Starting NewCloudService", slog.String("endpoint", conf.Endpoint), slog.String("apiVersion", conf.APIVersion))
+
+	return &CloudServiceImpl{
+		httpClient: &http.Client{Transport: acquireTransport(), Timeout: requestTimeout},
+		endpoint:   conf.Endpoint,
+		apiKey:     conf.APIKey,
+		apiVersion: conf.APIVersion,
+		maxRetries: maxRetries,
+		retryDelay: defaultRetryDelay,
+		logger:     logger,
+	}, nil
+}
+
+// do sends a request to the Temporal Cloud Operations API and decodes the
+// response into the given response, if it is not nil. Requests, which are
+// rate limited or rejected, because the API is unavailable, are retried with
+// exponential backoff. Retrying mutations is safe, because Temporal Cloud
+// deduplicates them by their asynchronous operation id.
+func (s *CloudServiceImpl) do(ctx context.Context, method string, path string, query url.Values, request interface{}, response interface{}) error {
+	var requestJson []byte
+	if request != nil {
+		var err error
+		requestJson, err = json.Marshal(request)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+	}
+
+	requestUrl := s.endpoint + path
+	if len(query) > 0 {
+		requestUrl += "?" + query.Encode()
+	}
+
+	responseJson, err := s.send(ctx, method, requestUrl, requestJson)
+	for attempt := 0; attempt < s.maxRetries && IsRetryable(err); attempt++ {
+		delay := s.backoff(err, attempt)
+		s.logger.Debug("Retry request to Temporal Cloud Operations API", slog.String("method", method), slog.String("path", path), slog.Duration("delay", delay), slog.String("error", err.Error()))
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+
+		responseJson, err = s.send(ctx, method, requestUrl, requestJson)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if response == nil || len(responseJson) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(responseJson, response)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal response")
+	}
+	return nil
+}
+
+// send sends a single request and returns the body of a successful response.
+func (s *CloudServiceImpl) send(ctx context.Context, method string, requestUrl string, requestJson []byte) ([]byte, error) {
+	var body io.Reader
+	if requestJson != nil {
+		body = bytes.NewReader(requestJson)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, method, requestUrl, body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest.Header.Set("Authorization", "Bearer "+s.apiKey)
+	httpRequest.Header.Set(headerAPIVersion, s.apiVersion)
+	if body != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+
+	s.logger.Debug("Request Temporal Cloud Operations API", slog.String("method", method), slog.String("path", httpRequest.URL.Path))
+	httpResponse, err := s.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+
+	responseJson, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+
+	if httpResponse.StatusCode < 200 || httpResponse.StatusCode >= 300 {
+		apiErr := &APIError{}
+		if json.Unmarshal(responseJson, apiErr) != nil || apiErr.Message == "" {
+			apiErr.Message = string(responseJson)
+		}
+		apiErr.StatusCode = httpResponse.StatusCode
+		if seconds, err := strconv.Atoi(httpResponse.Header.Get(headerRetryAfter)); err == nil && seconds > 0 {
+			apiErr.retryAfter = time.Duration(seconds) * time.Second
+		}
+		apiErr.requestId = httpResponse.Header.Get(headerRequestId)
+		return nil, apiErr
+	}
+
+	return responseJson, nil
+}
+
+// backoff returns the delay before the next attempt, which is the delay
+// requested by the server or doubles with each attempt.
+func (s *CloudServiceImpl) backoff(err error, attempt int) time.Duration {
+	delay := s.retryDelay << attempt
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.retryAfter > 0 {
+		delay = apiErr.retryAfter
+	}
+
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+func NewNamespaceService(configData []byte) (NamespaceService, error) {
+	return NewCloudService(configData)
+}
+
+func NewUserService(configData []byte) (UserService, error) {
+	return NewCloudService(configData)
+}
+
+func NewUserGroupService(configData []byte) (UserGroupService, error) {
+	return NewCloudService(configData)
+}
+
+func NewServiceAccountService(configData []byte) (ServiceAccountService, error) {
+	return NewCloudService(configData)
+}
+
+func NewApiKeyService(configData []byte) (ApiKeyService, error) {
+	return NewCloudService(configData)
+}
+
+func NewNamespaceAccessService(configData []byte) (NamespaceAccessService, error) {
+	return NewCloudService(configData)
+}
+
+func NewExportSinkService(configData []byte) (ExportSinkService, error) {
+	return NewCloudService(configData)
+}
+
+func NewMetricsEndpointService(configData []byte) (MetricsEndpointService, error) {
+	return NewCloudService(configData)
+}
+
+func NewNexusEndpointService(configData []byte) (NexusEndpointService, error) {
+	return NewCloudService(configData)
+}
+
+// NewSearchAttributeService manages search attributes of Temporal Cloud
+// namespaces via the Temporal Cloud Operations API.
+func NewSearchAttributeService(configData []byte) (temporal.SearchAttributeService, error) {
+	return NewCloudService(configData)
+}
+
+// Close releases the shared transport. Closing a service more than once has
+// no effect.
+func (s *CloudServiceImpl) Close() {
+	s.closeOnce.Do(releaseTransport)
+}
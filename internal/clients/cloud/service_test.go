@@ -0,0 +1,157 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+func TestRetryRateLimitedRequest(t *testing.T) {
+	requests := 0
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"code":8,"message":"rate limit exceeded"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"namespace":{"namespace":"test001.acct","state":"NAMESPACE_STATE_ACTIVE","spec":{"name":"test001"}}}`))
+	})
+	service.retryDelay = time.Millisecond
+
+	namespace, err := service.DescribeNamespace(context.Background(), "test001.acct")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if namespace == nil || requests != 2 {
+		t.Fatalf("expected namespace after 2 requests, got %v after %d requests", namespace, requests)
+	}
+}
+
+func TestRetryUnavailableRequestExhausted(t *testing.T) {
+	requests := 0
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`{"code":14,"message":"unavailable"}`))
+	})
+	service.retryDelay = time.Millisecond
+
+	_, err := service.DescribeNamespace(context.Background(), "test001.acct")
+	if !IsRetryable(err) {
+		t.Fatalf("expected retryable error, got %v", err)
+	}
+
+	if requests != defaultMaxRetries+1 {
+		t.Fatalf("expected %d requests, got %d", defaultMaxRetries+1, requests)
+	}
+}
+
+func TestPermissionDeniedIsTerminal(t *testing.T) {
+	requests := 0
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"code":7,"message":"permission denied"}`))
+	})
+	service.retryDelay = time.Millisecond
+
+	_, err := service.DescribeNamespace(context.Background(), "test001.acct")
+	if !temporal.IsTerminalError(err) {
+		t.Fatalf("expected terminal error, got %v", err)
+	}
+
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+
+	if temporal.IsTerminalError(&APIError{StatusCode: http.StatusBadRequest, Code: 9}) {
+		t.Fatal("expected FailedPrecondition not to be terminal")
+	}
+}
+
+func TestRequestDetails(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRequestId, "req-123")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"code":7,"message":"permission denied"}`))
+	})
+
+	_, err := service.DescribeNamespace(context.Background(), "test001.acct")
+	code, requestId, ok := temporal.RequestDetails(err)
+	if !ok || code != codes.PermissionDenied || requestId != "req-123" {
+		t.Fatalf("expected PermissionDenied with request id req-123, got %v, %v, %q", ok, code, requestId)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	service := &CloudServiceImpl{retryDelay: time.Second}
+
+	if delay := service.backoff(&APIError{StatusCode: http.StatusTooManyRequests}, 2); delay != 4*time.Second {
+		t.Fatalf("expected delay of 4s, got %s", delay)
+	}
+
+	if delay := service.backoff(&APIError{StatusCode: http.StatusTooManyRequests, retryAfter: 7 * time.Second}, 0); delay != 7*time.Second {
+		t.Fatalf("expected requested delay of 7s, got %s", delay)
+	}
+
+	if delay := service.backoff(&APIError{StatusCode: http.StatusServiceUnavailable}, 10); delay != maxRetryDelay {
+		t.Fatalf("expected maximal delay, got %s", delay)
+	}
+}
+
+func TestServicesShareConnections(t *testing.T) {
+	var connections atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"namespace":{"namespace":"test001.acct","state":"NAMESPACE_STATE_ACTIVE","spec":{"name":"test001"}}}`))
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+
+	config, err := json.Marshal(CloudServiceConfig{APIKey: "test-key", Endpoint: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each reconcile creates a service and closes it, when it disconnects
+	for i := 0; i < 2; i++ {
+		service, err := NewCloudService(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Keep the shared transport in use, like a service of another
+		// ProviderConfig does
+		other, err := NewCloudService(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer other.Close()
+
+		if _, err := service.DescribeNamespace(context.Background(), "test001.acct"); err != nil {
+			t.Fatal(err)
+		}
+		service.Close()
+		service.Close()
+	}
+
+	if count := connections.Load(); count != 1 {
+		t.Fatalf("expected 1 connection, got %d", count)
+	}
+}
@@ -0,0 +1,187 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+type ServiceAccountService interface {
+	DescribeServiceAccount(ctx context.Context, serviceAccountId string) (*cloud.CloudServiceAccountObservation, error)
+
+	CreateServiceAccount(ctx context.Context, serviceAccount *cloud.CloudServiceAccountParameters) (string, error)
+	UpdateServiceAccount(ctx context.Context, serviceAccountId string, serviceAccount *cloud.CloudServiceAccountParameters) (string, error)
+	DeleteServiceAccount(ctx context.Context, serviceAccountId string, resourceVersion string) error
+
+	MapToServiceAccountCompare(serviceAccount interface{}) (*ServiceAccountCompare, error)
+
+	AsyncOperationService
+
+	Close()
+}
+
+type ServiceAccountCompare struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	AccountRole string `json:"accountRole"`
+}
+
+type serviceAccountSpec struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Access      access `json:"access"`
+}
+
+type serviceAccount struct {
+	Id              string             `json:"id"`
+	ResourceVersion string             `json:"resourceVersion"`
+	Spec            serviceAccountSpec `json:"spec"`
+	State           string             `json:"state"`
+}
+
+type getServiceAccountResponse struct {
+	ServiceAccount *serviceAccount `json:"serviceAccount"`
+}
+
+type createServiceAccountRequest struct {
+	Spec             serviceAccountSpec `json:"spec"`
+	AsyncOperationId string             `json:"asyncOperationId,omitempty"`
+}
+
+type createServiceAccountResponse struct {
+	ServiceAccountId string `json:"serviceAccountId"`
+}
+
+type updateServiceAccountRequest struct {
+	Spec             serviceAccountSpec `json:"spec"`
+	ResourceVersion  string             `json:"resourceVersion"`
+	AsyncOperationId string             `json:"asyncOperationId,omitempty"`
+}
+
+func (s *CloudServiceImpl) MapToServiceAccountCompare(serviceAccount interface{}) (*ServiceAccountCompare, error) {
+	serviceAccountJson, err := json.Marshal(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	var serviceAccountCompare = ServiceAccountCompare{}
+	err = json.Unmarshal(serviceAccountJson, &serviceAccountCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceAccountCompare, nil
+}
+
+// DescribeServiceAccount returns the service account with the given id or nil
+// if it does not exist.
+func (s *CloudServiceImpl) DescribeServiceAccount(ctx context.Context, serviceAccountId string) (*cloud.CloudServiceAccountObservation, error) {
+	serviceAccount, err := s.getServiceAccount(ctx, serviceAccountId)
+	if err != nil || serviceAccount == nil {
+		return nil, err
+	}
+
+	return &cloud.CloudServiceAccountObservation{
+		Id:                serviceAccount.Id,
+		Name:              serviceAccount.Spec.Name,
+		Description:       serviceAccount.Spec.Description,
+		AccountRole:       mapAccountRole(serviceAccount.Spec.Access),
+		NamespaceAccesses: mapNamespaceAccesses(serviceAccount.Spec.Access),
+		State:             normalizeState(serviceAccount.State),
+		ResourceVersion:   serviceAccount.ResourceVersion,
+	}, nil
+}
+
+// CreateServiceAccount creates the service account and returns its id.
+func (s *CloudServiceImpl) CreateServiceAccount(ctx context.Context, serviceAccount *cloud.CloudServiceAccountParameters) (string, error) {
+	request := &createServiceAccountRequest{
+		Spec: serviceAccountSpec{
+			Name:        serviceAccount.Name,
+			Description: serviceAccount.Description,
+			Access: access{
+				AccountAccess: &accountAccess{Role: toApiValue(serviceAccount.AccountRole)},
+			},
+		},
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	response := &createServiceAccountResponse{}
+	err := s.do(ctx, http.MethodPost, "/cloud/service-accounts", nil, request, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.ServiceAccountId, nil
+}
+
+// UpdateServiceAccount changes the name, the description and the account role
+// of the service account and returns the id of the asynchronous operation.
+// The namespace accesses are not managed by the CloudServiceAccount, therefore
+// the current ones are kept.
+func (s *CloudServiceImpl) UpdateServiceAccount(ctx context.Context, serviceAccountId string, serviceAccount *cloud.CloudServiceAccountParameters) (string, error) {
+	current, err := s.getServiceAccount(ctx, serviceAccountId)
+	if err != nil {
+		return "", err
+	}
+
+	if current == nil {
+		return "", &APIError{StatusCode: http.StatusNotFound, Message: "service account '" + serviceAccountId + "' not found"}
+	}
+
+	spec := current.Spec
+	spec.Name = serviceAccount.Name
+	spec.Description = serviceAccount.Description
+	spec.Access.AccountAccess = &accountAccess{Role: toApiValue(serviceAccount.AccountRole)}
+
+	return s.updateServiceAccount(ctx, current, spec)
+}
+
+func (s *CloudServiceImpl) DeleteServiceAccount(ctx context.Context, serviceAccountId string, resourceVersion string) error {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	err := s.do(ctx, http.MethodDelete, "/cloud/service-accounts/"+url.PathEscape(serviceAccountId), query, nil, nil)
+
+	if IsNotFound(err) {
+		s.logger.Debug("ServiceAccount '" + serviceAccountId + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+func (s *CloudServiceImpl) getServiceAccount(ctx context.Context, serviceAccountId string) (*serviceAccount, error) {
+	response := &getServiceAccountResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/service-accounts/"+url.PathEscape(serviceAccountId), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("ServiceAccount '" + serviceAccountId + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.ServiceAccount == nil || normalizeState(response.ServiceAccount.State) == "deleted" {
+		return nil, nil
+	}
+
+	return response.ServiceAccount, nil
+}
+
+func (s *CloudServiceImpl) updateServiceAccount(ctx context.Context, current *serviceAccount, spec serviceAccountSpec) (string, error) {
+	request := &updateServiceAccountRequest{
+		Spec:             spec,
+		ResourceVersion:  current.ResourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/service-accounts/"+url.PathEscape(current.Id), nil, request, request.AsyncOperationId)
+}
@@ -0,0 +1,179 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+type UserService interface {
+	DescribeUser(ctx context.Context, userId string) (*cloud.CloudUserObservation, error)
+
+	CreateUser(ctx context.Context, user *cloud.CloudUserParameters) (string, error)
+	UpdateUser(ctx context.Context, userId string, user *cloud.CloudUserParameters) (string, error)
+	DeleteUser(ctx context.Context, userId string, resourceVersion string) error
+
+	MapToUserCompare(user interface{}) (*UserCompare, error)
+
+	AsyncOperationService
+
+	Close()
+}
+
+type UserCompare struct {
+	Email       string `json:"email"`
+	AccountRole string `json:"accountRole"`
+}
+
+type userSpec struct {
+	Email  string `json:"email"`
+	Access access `json:"access"`
+}
+
+type user struct {
+	Id              string   `json:"id"`
+	ResourceVersion string   `json:"resourceVersion"`
+	Spec            userSpec `json:"spec"`
+	State           string   `json:"state"`
+}
+
+type getUserResponse struct {
+	User *user `json:"user"`
+}
+
+type createUserRequest struct {
+	Spec             userSpec `json:"spec"`
+	AsyncOperationId string   `json:"asyncOperationId,omitempty"`
+}
+
+type createUserResponse struct {
+	UserId string `json:"userId"`
+}
+
+type updateUserRequest struct {
+	Spec             userSpec `json:"spec"`
+	ResourceVersion  string   `json:"resourceVersion"`
+	AsyncOperationId string   `json:"asyncOperationId,omitempty"`
+}
+
+func (s *CloudServiceImpl) MapToUserCompare(user interface{}) (*UserCompare, error) {
+	userJson, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var userCompare = UserCompare{}
+	err = json.Unmarshal(userJson, &userCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userCompare, nil
+}
+
+// DescribeUser returns the user with the given id or nil if it does not exist.
+func (s *CloudServiceImpl) DescribeUser(ctx context.Context, userId string) (*cloud.CloudUserObservation, error) {
+	user, err := s.getUser(ctx, userId)
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	return &cloud.CloudUserObservation{
+		Id:                user.Id,
+		Email:             user.Spec.Email,
+		AccountRole:       mapAccountRole(user.Spec.Access),
+		NamespaceAccesses: mapNamespaceAccesses(user.Spec.Access),
+		State:             normalizeState(user.State),
+		ResourceVersion:   user.ResourceVersion,
+	}, nil
+}
+
+// CreateUser invites the user to the account and returns its id.
+func (s *CloudServiceImpl) CreateUser(ctx context.Context, user *cloud.CloudUserParameters) (string, error) {
+	request := &createUserRequest{
+		Spec: userSpec{
+			Email: user.Email,
+			Access: access{
+				AccountAccess: &accountAccess{Role: toApiValue(user.AccountRole)},
+			},
+		},
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	response := &createUserResponse{}
+	err := s.do(ctx, http.MethodPost, "/cloud/users", nil, request, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.UserId, nil
+}
+
+// UpdateUser changes the account role of the user and returns the id of the
+// asynchronous operation. The namespace accesses are not managed by the
+// CloudUser, therefore the current ones are kept.
+func (s *CloudServiceImpl) UpdateUser(ctx context.Context, userId string, user *cloud.CloudUserParameters) (string, error) {
+	current, err := s.getUser(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+
+	if current == nil {
+		return "", &APIError{StatusCode: http.StatusNotFound, Message: "user '" + userId + "' not found"}
+	}
+
+	spec := current.Spec
+	spec.Access.AccountAccess = &accountAccess{Role: toApiValue(user.AccountRole)}
+
+	return s.updateUser(ctx, current, spec)
+}
+
+func (s *CloudServiceImpl) DeleteUser(ctx context.Context, userId string, resourceVersion string) error {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	err := s.do(ctx, http.MethodDelete, "/cloud/users/"+url.PathEscape(userId), query, nil, nil)
+
+	if IsNotFound(err) {
+		s.logger.Debug("User '" + userId + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+func (s *CloudServiceImpl) getUser(ctx context.Context, userId string) (*user, error) {
+	response := &getUserResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/users/"+url.PathEscape(userId), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("User '" + userId + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.User == nil || normalizeState(response.User.State) == "deleted" {
+		return nil, nil
+	}
+
+	return response.User, nil
+}
+
+func (s *CloudServiceImpl) updateUser(ctx context.Context, current *user, spec userSpec) (string, error) {
+	request := &updateUserRequest{
+		Spec:             spec,
+		ResourceVersion:  current.ResourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/users/"+url.PathEscape(current.Id), nil, request, request.AsyncOperationId)
+}
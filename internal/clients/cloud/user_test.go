@@ -0,0 +1,84 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+func TestDescribeUser(t *testing.T) {
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/cloud/users/user1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"user":{"id":"user1","resourceVersion":"rv1","state":"active",
+			"spec":{"email":"test@test.local","access":{
+				"accountAccess":{"role":"ROLE_FINANCE_ADMIN"},
+				"namespaceAccesses":{"ns2.acct":{"permission":"write"},"ns1.acct":{"permission":"PERMISSION_READ"}}}}}}`))
+	})
+
+	user, err := service.DescribeUser(context.Background(), "user1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &cloud.CloudUserObservation{
+		Id:          "user1",
+		Email:       "test@test.local",
+		AccountRole: "FinanceAdmin",
+		NamespaceAccesses: []cloud.NamespaceAccess{
+			{Namespace: "ns1.acct", Permission: "Read"},
+			{Namespace: "ns2.acct", Permission: "Write"},
+		},
+		State:           "active",
+		ResourceVersion: "rv1",
+	}
+
+	expectedJson, _ := json.Marshal(expected)
+	userJson, _ := json.Marshal(user)
+	if string(expectedJson) != string(userJson) {
+		t.Fatalf("expected %s, got %s", expectedJson, userJson)
+	}
+}
+
+func TestUpdateUserKeepsNamespaceAccesses(t *testing.T) {
+	updated := false
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"user":{"id":"user2","resourceVersion":"rv2","state":"active",
+				"spec":{"email":"test@test.local","access":{
+					"accountAccess":{"role":"read"},
+					"namespaceAccesses":{"ns1.acct":{"permission":"admin"}}}}}}`))
+		case http.MethodPost:
+			request := &updateUserRequest{}
+			if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+				t.Error(err)
+			}
+			if request.ResourceVersion != "rv2" || request.Spec.Access.AccountAccess.Role != "developer" {
+				t.Errorf("unexpected request %v", request)
+			}
+			if request.Spec.Access.NamespaceAccesses["ns1.acct"].Permission != "admin" {
+				t.Errorf("expected namespace access to be kept, got %v", request.Spec.Access.NamespaceAccesses)
+			}
+			updated = true
+			_, _ = w.Write([]byte(`{}`))
+		}
+	})
+
+	_, err := service.UpdateUser(context.Background(), "user2", &cloud.CloudUserParameters{
+		Email:       "test@test.local",
+		AccountRole: "Developer",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !updated {
+		t.Fatal("user was not updated")
+	}
+}
@@ -0,0 +1,295 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/google/uuid"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+type UserGroupService interface {
+	DescribeUserGroup(ctx context.Context, groupId string) (*cloud.CloudUserGroupObservation, error)
+
+	CreateUserGroup(ctx context.Context, group *cloud.CloudUserGroupParameters) (string, error)
+	UpdateUserGroup(ctx context.Context, groupId string, group *cloud.CloudUserGroupParameters) (string, error)
+	DeleteUserGroup(ctx context.Context, groupId string, resourceVersion string) error
+
+	MapToUserGroupCompare(group interface{}) (*UserGroupCompare, error)
+
+	AsyncOperationService
+
+	Close()
+}
+
+type UserGroupCompare struct {
+	DisplayName   string   `json:"displayName"`
+	AccountRole   string   `json:"accountRole"`
+	MemberUserIds []string `json:"memberUserIds,omitempty"`
+}
+
+type userGroupSpec struct {
+	DisplayName string `json:"displayName"`
+	Access      access `json:"access"`
+
+	// CloudGroup marks the group as a group, whose members are managed in
+	// Temporal Cloud instead of an identity provider.
+	CloudGroup *struct{} `json:"cloudGroup,omitempty"`
+}
+
+type userGroup struct {
+	Id              string        `json:"id"`
+	ResourceVersion string        `json:"resourceVersion"`
+	Spec            userGroupSpec `json:"spec"`
+	State           string        `json:"state"`
+}
+
+type getUserGroupResponse struct {
+	Group *userGroup `json:"group"`
+}
+
+type createUserGroupRequest struct {
+	Spec             userGroupSpec `json:"spec"`
+	AsyncOperationId string        `json:"asyncOperationId,omitempty"`
+}
+
+type createUserGroupResponse struct {
+	GroupId string `json:"groupId"`
+}
+
+type updateUserGroupRequest struct {
+	Spec             userGroupSpec `json:"spec"`
+	ResourceVersion  string        `json:"resourceVersion"`
+	AsyncOperationId string        `json:"asyncOperationId,omitempty"`
+}
+
+type userGroupMemberId struct {
+	UserId string `json:"userId"`
+}
+
+type userGroupMember struct {
+	MemberId userGroupMemberId `json:"memberId"`
+}
+
+type getUserGroupMembersResponse struct {
+	Members       []userGroupMember `json:"members"`
+	NextPageToken string            `json:"nextPageToken"`
+}
+
+type userGroupMemberRequest struct {
+	MemberId         userGroupMemberId `json:"memberId"`
+	AsyncOperationId string            `json:"asyncOperationId,omitempty"`
+}
+
+func (s *CloudServiceImpl) MapToUserGroupCompare(group interface{}) (*UserGroupCompare, error) {
+	groupJson, err := json.Marshal(group)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupCompare = UserGroupCompare{}
+	err = json.Unmarshal(groupJson, &groupCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(groupCompare.MemberUserIds)
+	return &groupCompare, nil
+}
+
+// DescribeUserGroup returns the group with the given id and its members or
+// nil if it does not exist.
+func (s *CloudServiceImpl) DescribeUserGroup(ctx context.Context, groupId string) (*cloud.CloudUserGroupObservation, error) {
+	group, err := s.getUserGroup(ctx, groupId)
+	if err != nil || group == nil {
+		return nil, err
+	}
+
+	members, err := s.listUserGroupMembers(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cloud.CloudUserGroupObservation{
+		Id:                group.Id,
+		DisplayName:       group.Spec.DisplayName,
+		AccountRole:       mapAccountRole(group.Spec.Access),
+		MemberUserIds:     members,
+		NamespaceAccesses: mapNamespaceAccesses(group.Spec.Access),
+		State:             normalizeState(group.State),
+		ResourceVersion:   group.ResourceVersion,
+	}, nil
+}
+
+// CreateUserGroup creates the group and returns its id. The members are added
+// by UpdateUserGroup, when the group is active.
+func (s *CloudServiceImpl) CreateUserGroup(ctx context.Context, group *cloud.CloudUserGroupParameters) (string, error) {
+	request := &createUserGroupRequest{
+		Spec: userGroupSpec{
+			DisplayName: group.DisplayName,
+			Access: access{
+				AccountAccess: &accountAccess{Role: toApiValue(group.AccountRole)},
+			},
+			CloudGroup: &struct{}{},
+		},
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	response := &createUserGroupResponse{}
+	err := s.do(ctx, http.MethodPost, "/cloud/user-groups", nil, request, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.GroupId, nil
+}
+
+// UpdateUserGroup changes the display name and the account role of the group
+// and adds or removes members until they match the desired members. It
+// returns the id of the last asynchronous operation. The namespace accesses
+// are not managed by the CloudUserGroup, therefore the current ones are kept.
+func (s *CloudServiceImpl) UpdateUserGroup(ctx context.Context, groupId string, group *cloud.CloudUserGroupParameters) (string, error) {
+	current, err := s.getUserGroup(ctx, groupId)
+	if err != nil {
+		return "", err
+	}
+
+	if current == nil {
+		return "", &APIError{StatusCode: http.StatusNotFound, Message: "group '" + groupId + "' not found"}
+	}
+
+	asyncOperationId := ""
+	role := toApiValue(group.AccountRole)
+	if current.Spec.DisplayName != group.DisplayName || mapAccountRole(current.Spec.Access) != group.AccountRole {
+		spec := current.Spec
+		spec.DisplayName = group.DisplayName
+		spec.Access.AccountAccess = &accountAccess{Role: role}
+
+		asyncOperationId, err = s.updateUserGroup(ctx, current, spec)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	members, err := s.listUserGroupMembers(ctx, groupId)
+	if err != nil {
+		return "", err
+	}
+
+	for _, userId := range group.MemberUserIds {
+		if !contains(members, userId) {
+			asyncOperationId, err = s.changeUserGroupMember(ctx, groupId, "/members", userId)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	for _, userId := range members {
+		if !contains(group.MemberUserIds, userId) {
+			asyncOperationId, err = s.changeUserGroupMember(ctx, groupId, "/remove-member", userId)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return asyncOperationId, nil
+}
+
+func (s *CloudServiceImpl) DeleteUserGroup(ctx context.Context, groupId string, resourceVersion string) error {
+	query := url.Values{}
+	query.Set("resourceVersion", resourceVersion)
+	query.Set("asyncOperationId", uuid.New().String())
+
+	err := s.do(ctx, http.MethodDelete, "/cloud/user-groups/"+url.PathEscape(groupId), query, nil, nil)
+
+	if IsNotFound(err) {
+		s.logger.Debug("Group '" + groupId + "' not found. " + err.Error())
+		return nil
+	}
+
+	return err
+}
+
+func (s *CloudServiceImpl) getUserGroup(ctx context.Context, groupId string) (*userGroup, error) {
+	response := &getUserGroupResponse{}
+	err := s.do(ctx, http.MethodGet, "/cloud/user-groups/"+url.PathEscape(groupId), nil, nil, response)
+
+	if IsNotFound(err) {
+		s.logger.Debug("Group '" + groupId + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Group == nil || normalizeState(response.Group.State) == "deleted" {
+		return nil, nil
+	}
+
+	return response.Group, nil
+}
+
+func (s *CloudServiceImpl) updateUserGroup(ctx context.Context, current *userGroup, spec userGroupSpec) (string, error) {
+	request := &updateUserGroupRequest{
+		Spec:             spec,
+		ResourceVersion:  current.ResourceVersion,
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/user-groups/"+url.PathEscape(current.Id), nil, request, request.AsyncOperationId)
+}
+
+// listUserGroupMembers returns the sorted ids of the users, which are members
+// of the group.
+func (s *CloudServiceImpl) listUserGroupMembers(ctx context.Context, groupId string) ([]string, error) {
+	var members []string
+	query := url.Values{}
+
+	for {
+		response := &getUserGroupMembersResponse{}
+		err := s.do(ctx, http.MethodGet, "/cloud/user-groups/"+url.PathEscape(groupId)+"/members", query, nil, response)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range response.Members {
+			if member.MemberId.UserId != "" {
+				members = append(members, member.MemberId.UserId)
+			}
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		query.Set("pageToken", response.NextPageToken)
+	}
+
+	sort.Strings(members)
+	return members, nil
+}
+
+func (s *CloudServiceImpl) changeUserGroupMember(ctx context.Context, groupId string, action string, userId string) (string, error) {
+	request := &userGroupMemberRequest{
+		MemberId:         userGroupMemberId{UserId: userId},
+		AsyncOperationId: uuid.New().String(),
+	}
+
+	s.logger.Debug("Change member '" + userId + "' of group '" + groupId + "' (" + action + ")")
+	return s.startAsyncOperation(ctx, http.MethodPost, "/cloud/user-groups/"+url.PathEscape(groupId)+action, nil, request, request.AsyncOperationId)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
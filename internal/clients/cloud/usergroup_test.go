@@ -0,0 +1,58 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"testing"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+func TestUpdateUserGroupReconcilesMembers(t *testing.T) {
+	var changes []string
+	service := createCloudService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /cloud/user-groups/group1":
+			_, _ = w.Write([]byte(`{"group":{"id":"group1","resourceVersion":"rv1","state":"active",
+				"spec":{"displayName":"Group 1","access":{"accountAccess":{"role":"read"}},"cloudGroup":{}}}}`))
+		case "GET /cloud/user-groups/group1/members":
+			if r.URL.Query().Get("pageToken") == "" {
+				_, _ = w.Write([]byte(`{"members":[{"memberId":{"userId":"user1"}}],"nextPageToken":"page2"}`))
+			} else {
+				_, _ = w.Write([]byte(`{"members":[{"memberId":{"userId":"user2"}}]}`))
+			}
+		case "POST /cloud/user-groups/group1/members", "POST /cloud/user-groups/group1/remove-member":
+			request := &userGroupMemberRequest{}
+			if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+				t.Error(err)
+			}
+			changes = append(changes, r.URL.Path+" "+request.MemberId.UserId)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	_, err := service.UpdateUserGroup(context.Background(), "group1", &cloud.CloudUserGroupParameters{
+		DisplayName:   "Group 1",
+		AccountRole:   "Read",
+		MemberUserIds: []string{"user2", "user3"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(changes)
+	expected := []string{
+		"/cloud/user-groups/group1/members user3",
+		"/cloud/user-groups/group1/remove-member user1",
+	}
+
+	changesJson, _ := json.Marshal(changes)
+	expectedJson, _ := json.Marshal(expected)
+	if string(changesJson) != string(expectedJson) {
+		t.Fatalf("expected %s, got %s", expectedJson, changesJson)
+	}
+}
@@ -0,0 +1,72 @@
+// Package compare normalizes the fields of specs and observations, before they
+// are compared to detect drift. So a managed resource is only updated, if its
+// spec differs from the observed external resource in meaning, but not, if
+// it only differs in representation, e.g. in white space, case or order.
+package compare
+
+import (
+	"sort"
+	"strings"
+)
+
+// String returns a copy of the value without leading and trailing white space.
+// An unset value stays unset, so that it is not mistaken for an empty value.
+func String(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	return &trimmed
+}
+
+// Enum returns the known value, which equals the value ignoring case. Unknown
+// values are returned without leading and trailing white space.
+func Enum(value string, known ...string) string {
+	trimmed := strings.TrimSpace(value)
+	for _, k := range known {
+		if strings.EqualFold(trimmed, k) {
+			return k
+		}
+	}
+	return trimmed
+}
+
+// Strings returns a sorted copy of the values, for values whose order is
+// irrelevant. Empty values are returned as nil.
+func Strings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// Map returns a copy of the map. An empty map is returned as nil.
+func Map(values map[string]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	copied := make(map[string]string, len(values))
+	for key, value := range values {
+		copied[key] = value
+	}
+	return copied
+}
+
+// MapPtr returns a copy of the map. Unlike Map an empty map is kept, so that
+// a map, which is specified to be empty, is distinguished from an unset map.
+func MapPtr(values *map[string]string) *map[string]string {
+	if values == nil {
+		return nil
+	}
+
+	copied := Map(*values)
+	if copied == nil {
+		copied = map[string]string{}
+	}
+	return &copied
+}
@@ -0,0 +1,110 @@
+package compare
+
+import (
+	"reflect"
+	"testing"
+)
+
+func ptr(value string) *string {
+	return &value
+}
+
+func TestString(t *testing.T) {
+	cases := map[string]struct {
+		value *string
+		want  *string
+	}{
+		"Unset":      {value: nil, want: nil},
+		"Empty":      {value: ptr(""), want: ptr("")},
+		"Trimmed":    {value: ptr(" test\n"), want: ptr("test")},
+		"WhiteSpace": {value: ptr("  "), want: ptr("")},
+		"Inner":      {value: ptr("a test"), want: ptr("a test")},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := String(tc.value)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("String(): want %v, got %v", tc.want, got)
+			}
+			if got != nil && got == tc.value {
+				t.Fatal("String(): expected a copy of the value")
+			}
+		})
+	}
+}
+
+func TestEnum(t *testing.T) {
+	known := []string{"Disabled", "Enabled"}
+
+	cases := map[string]struct {
+		value string
+		want  string
+	}{
+		"Known":     {value: "Enabled", want: "Enabled"},
+		"LowerCase": {value: "enabled", want: "Enabled"},
+		"UpperCase": {value: "DISABLED", want: "Disabled"},
+		"Trimmed":   {value: " Enabled ", want: "Enabled"},
+		"Unknown":   {value: " Unspecified", want: "Unspecified"},
+		"Empty":     {value: "", want: ""},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := Enum(tc.value, known...); got != tc.want {
+				t.Fatalf("Enum(): want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestStrings(t *testing.T) {
+	values := []string{"b", "c", "a"}
+
+	if got := Strings(values); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("Strings(): want sorted values, got %v", got)
+	}
+	if !reflect.DeepEqual(values, []string{"b", "c", "a"}) {
+		t.Fatalf("Strings(): expected values not to be sorted in place, got %v", values)
+	}
+	if got := Strings([]string{}); got != nil {
+		t.Fatalf("Strings(): want nil for empty values, got %v", got)
+	}
+}
+
+func TestMap(t *testing.T) {
+	values := map[string]string{"a": "1"}
+
+	got := Map(values)
+	if !reflect.DeepEqual(got, values) {
+		t.Fatalf("Map(): want %v, got %v", values, got)
+	}
+	got["b"] = "2"
+	if len(values) != 1 {
+		t.Fatal("Map(): expected a copy of the map")
+	}
+	if got := Map(map[string]string{}); got != nil {
+		t.Fatalf("Map(): want nil for empty map, got %v", got)
+	}
+}
+
+func TestMapPtr(t *testing.T) {
+	if got := MapPtr(nil); got != nil {
+		t.Fatalf("MapPtr(): want nil for unset map, got %v", *got)
+	}
+
+	empty := map[string]string{}
+	if got := MapPtr(&empty); got == nil || len(*got) != 0 {
+		t.Fatalf("MapPtr(): want empty map for empty map, got %v", got)
+	}
+
+	values := map[string]string{"a": "1"}
+	got := MapPtr(&values)
+	if !reflect.DeepEqual(*got, values) {
+		t.Fatalf("MapPtr(): want %v, got %v", values, *got)
+	}
+	(*got)["b"] = "2"
+	if len(values) != 1 {
+		t.Fatal("MapPtr(): expected a copy of the map")
+	}
+}
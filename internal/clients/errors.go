@@ -0,0 +1,178 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gogo/googleapis/google/rpc"
+	"github.com/gogo/protobuf/types"
+	gogostatus "github.com/gogo/status"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestIdHeaders are the response headers, which carry the id of a request,
+// if the server or a proxy in front of it provides one.
+var requestIdHeaders = []string{"x-request-id", "request-id"}
+
+// classifiedError is implemented by errors of other APIs, e.g. the Temporal
+// Cloud Operations API, which classify themselves.
+type classifiedError interface {
+	Terminal() bool
+}
+
+// exhaustedError is implemented by errors of other APIs, e.g. the Temporal
+// Cloud Operations API, which report their rate limits themselves.
+type exhaustedError interface {
+	ResourceExhausted() (time.Duration, bool)
+}
+
+// detailedError is implemented by errors of other APIs, e.g. the Temporal
+// Cloud Operations API, which report the gRPC code and the request id of the
+// failed request themselves.
+type detailedError interface {
+	RequestDetails() (codes.Code, string)
+}
+
+// statusError is implemented by the errors of the serviceerror package, which
+// carry gogo statuses.
+type statusError interface {
+	Status() *gogostatus.Status
+}
+
+// grpcStatusError is implemented by the errors of the grpc and gogo status
+// packages.
+type grpcStatusError interface {
+	GRPCStatus() *status.Status
+}
+
+// IsTerminalError returns true, if the server rejected a request, which does
+// not succeed when it is retried unchanged, e.g. because of missing
+// permissions or an invalid argument. Other errors, e.g. Unavailable or
+// DeadlineExceeded, are retryable.
+func IsTerminalError(err error) bool {
+	var permissionDenied *serviceerror.PermissionDenied
+	var invalidArgument *serviceerror.InvalidArgument
+	var unimplemented *serviceerror.Unimplemented
+	if errors.As(err, &permissionDenied) || errors.As(err, &invalidArgument) || errors.As(err, &unimplemented) {
+		return true
+	}
+
+	var classified classifiedError
+	return errors.As(err, &classified) && classified.Terminal()
+}
+
+// ResourceExhausted returns true, if the server rejected a request, because a
+// rate limit was exceeded. It also returns the delay, which the server
+// requested before retrying, or 0 if the server did not request any.
+func ResourceExhausted(err error) (time.Duration, bool) {
+	var exhausted exhaustedError
+	if errors.As(err, &exhausted) {
+		return exhausted.ResourceExhausted()
+	}
+
+	var resourceExhausted *serviceerror.ResourceExhausted
+	if !errors.As(err, &resourceExhausted) {
+		return 0, false
+	}
+
+	return retryDelay(resourceExhausted.Status()), true
+}
+
+// retryDelay returns the delay of the RetryInfo details of the status. The
+// errors of the serviceerror package carry gogo statuses, whose details are
+// gogo messages.
+func retryDelay(st *gogostatus.Status) time.Duration {
+	if st == nil {
+		return 0
+	}
+
+	for _, detail := range st.Details() {
+		retryInfo, ok := detail.(*rpc.RetryInfo)
+		if !ok || retryInfo.GetRetryDelay() == nil {
+			continue
+		}
+		if delay, err := types.DurationFromProto(retryInfo.GetRetryDelay()); err == nil {
+			return delay
+		}
+	}
+	return 0
+}
+
+// RequestDetails returns the gRPC code and the request id of a failed request,
+// so that the failure can be correlated with the logs of the server. The
+// request id is empty, if the server did not provide one. It returns false, if
+// the error was not returned by the server, e.g. because the connection
+// failed.
+func RequestDetails(err error) (codes.Code, string, bool) {
+	var detailed detailedError
+	if errors.As(err, &detailed) {
+		code, requestId := detailed.RequestDetails()
+		return code, requestId, code != codes.OK || requestId != ""
+	}
+
+	st := statusOf(err)
+	if st == nil || st.Code() == codes.OK {
+		return codes.OK, "", false
+	}
+	return st.Code(), requestId(st), true
+}
+
+// statusOf returns the status of a serviceerror or of a gRPC error, or nil if
+// the error has no status.
+func statusOf(err error) *gogostatus.Status {
+	var withStatus statusError
+	if errors.As(err, &withStatus) {
+		return withStatus.Status()
+	}
+
+	var withGRPCStatus grpcStatusError
+	if errors.As(err, &withGRPCStatus) {
+		return gogostatus.FromGRPCStatus(withGRPCStatus.GRPCStatus())
+	}
+	return nil
+}
+
+// requestId returns the request id of the RequestInfo details of the status.
+func requestId(st *gogostatus.Status) string {
+	for _, detail := range st.Details() {
+		if requestInfo, ok := detail.(*rpc.RequestInfo); ok && requestInfo.GetRequestId() != "" {
+			return requestInfo.GetRequestId()
+		}
+	}
+	return ""
+}
+
+// requestIdInterceptor adds the request id of the response headers of a failed
+// request to the details of its status, unless the server already added one.
+// The details are gogo messages, so that they are kept when the Temporal SDK
+// converts the status to a serviceerror.
+func requestIdInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	if err == nil {
+		return nil
+	}
+
+	st := statusOf(err)
+	if st == nil || st.Code() == codes.OK || requestId(st) != "" {
+		return err
+	}
+
+	for _, key := range requestIdHeaders {
+		for _, md := range []metadata.MD{header, trailer} {
+			if values := md.Get(key); len(values) > 0 && values[0] != "" {
+				withRequestId, detailsErr := st.WithDetails(&rpc.RequestInfo{RequestId: values[0]})
+				if detailsErr != nil {
+					return err
+				}
+				return withRequestId.Err()
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,164 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/googleapis/google/rpc"
+	"github.com/gogo/protobuf/types"
+	gogostatus "github.com/gogo/status"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testClassifiedError struct {
+	terminal bool
+}
+
+func (e *testClassifiedError) Error() string {
+	return "classified"
+}
+
+func (e *testClassifiedError) Terminal() bool {
+	return e.terminal
+}
+
+func TestIsTerminalError(t *testing.T) {
+	terminal := []error{
+		serviceerror.NewPermissionDenied("permission denied", ""),
+		serviceerror.NewInvalidArgument("invalid argument"),
+		serviceerror.NewUnimplemented("not implemented"),
+		fmt.Errorf("cannot describe: %w", serviceerror.NewPermissionDenied("permission denied", "")),
+		&testClassifiedError{terminal: true},
+	}
+
+	for _, err := range terminal {
+		if !IsTerminalError(err) {
+			t.Errorf("Expected %v to be terminal", err)
+		}
+	}
+
+	retryable := []error{
+		nil,
+		serviceerror.NewUnavailable("unavailable"),
+		serviceerror.NewDeadlineExceeded("deadline exceeded"),
+		serviceerror.NewNotFound("not found"),
+		context.DeadlineExceeded,
+		&testClassifiedError{terminal: false},
+	}
+
+	for _, err := range retryable {
+		if IsTerminalError(err) {
+			t.Errorf("Expected %v to be retryable", err)
+		}
+	}
+}
+
+func TestResourceExhausted(t *testing.T) {
+	st, err := gogostatus.New(codes.ResourceExhausted, "namespace rate limit exceeded").
+		WithDetails(&rpc.RetryInfo{RetryDelay: types.DurationProto(3 * time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delay, exhausted := ResourceExhausted(fmt.Errorf("cannot describe: %w", serviceerror.FromStatus(st)))
+	if !exhausted || delay != 3*time.Second {
+		t.Fatalf("Expected ResourceExhausted with a delay of 3s, got %v, %v", exhausted, delay)
+	}
+
+	delay, exhausted = ResourceExhausted(serviceerror.NewResourceExhausted(0, "rps limit exceeded"))
+	if !exhausted || delay != 0 {
+		t.Fatalf("Expected ResourceExhausted without delay, got %v, %v", exhausted, delay)
+	}
+
+	if _, exhausted := ResourceExhausted(serviceerror.NewUnavailable("unavailable")); exhausted {
+		t.Fatal("Expected Unavailable not to be ResourceExhausted")
+	}
+}
+
+func TestRequestDetails(t *testing.T) {
+	st, err := gogostatus.New(codes.Unavailable, "unavailable").WithDetails(&rpc.RequestInfo{RequestId: "req-123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, requestId, ok := RequestDetails(fmt.Errorf("cannot describe: %w", serviceerror.FromStatus(st)))
+	if !ok || code != codes.Unavailable || requestId != "req-123" {
+		t.Fatalf("Expected Unavailable with request id req-123, got %v, %v, %q", ok, code, requestId)
+	}
+
+	code, requestId, ok = RequestDetails(serviceerror.NewNamespaceNotFound("test"))
+	if !ok || code != codes.NotFound || requestId != "" {
+		t.Fatalf("Expected NotFound without request id, got %v, %v, %q", ok, code, requestId)
+	}
+
+	code, _, ok = RequestDetails(fmt.Errorf("cannot describe: %w", status.Error(codes.Internal, "internal")))
+	if !ok || code != codes.Internal {
+		t.Fatalf("Expected Internal, got %v, %v", ok, code)
+	}
+
+	for _, err := range []error{nil, context.DeadlineExceeded, fmt.Errorf("namespace not set")} {
+		if _, _, ok := RequestDetails(err); ok {
+			t.Errorf("Expected no request details of %v", err)
+		}
+	}
+}
+
+// invokeWithRequestId invokes the requestIdInterceptor like the Temporal SDK,
+// i.e. its error is converted to a serviceerror, with a response, which
+// fails with the error and has the response header.
+func invokeWithRequestId(header metadata.MD, err error) error {
+	invoker := func(_ context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+		for _, opt := range opts {
+			if h, ok := opt.(grpc.HeaderCallOption); ok {
+				*h.HeaderAddr = header
+			}
+		}
+		return err
+	}
+
+	return serviceerror.FromStatus(gogostatus.Convert(requestIdInterceptor(context.Background(), "/test", nil, nil, nil, invoker)))
+}
+
+func TestRequestIdInterceptor(t *testing.T) {
+	err := invokeWithRequestId(metadata.Pairs("x-request-id", "req-123"), status.Error(codes.Unavailable, "unavailable"))
+	var unavailable *serviceerror.Unavailable
+	if !errors.As(err, &unavailable) {
+		t.Fatalf("Expected Unavailable, got %T", err)
+	}
+	if code, requestId, _ := RequestDetails(err); code != codes.Unavailable || requestId != "req-123" {
+		t.Fatalf("Expected Unavailable with request id req-123 of the header, got %v, %q", code, requestId)
+	}
+
+	st, err := gogostatus.New(codes.Unavailable, "unavailable").WithDetails(&rpc.RequestInfo{RequestId: "server"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = invokeWithRequestId(metadata.Pairs("x-request-id", "proxy"), st.Err())
+	if _, requestId, _ := RequestDetails(err); requestId != "server" {
+		t.Fatalf("Expected request id of the server to be kept, got %q", requestId)
+	}
+
+	if err := invokeWithRequestId(metadata.Pairs("x-request-id", "req-123"), nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestRequestIdInterceptorKeepsServiceError(t *testing.T) {
+	err := invokeWithRequestId(metadata.Pairs("x-request-id", "req-123"), serviceerror.NewNamespaceNotFound("test").(*serviceerror.NamespaceNotFound).Status().Err())
+
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	if !errors.As(err, &namespaceNotFound) || namespaceNotFound.Namespace != "test" {
+		t.Fatalf("Expected NamespaceNotFound of namespace test, got %T: %v", err, err)
+	}
+
+	if code, requestId, ok := RequestDetails(err); !ok || code != codes.NotFound || requestId != "req-123" {
+		t.Fatalf("Expected NotFound with request id req-123, got %v, %v, %q", ok, code, requestId)
+	}
+}
@@ -0,0 +1,49 @@
+package clients
+
+import (
+	"os"
+
+	"golang.org/x/exp/slog"
+)
+
+const (
+	// LogFormatJSON logs each message as JSON object.
+	LogFormatJSON = "json"
+
+	// LogFormatText logs each message as key=value pairs.
+	LogFormatText = "text"
+)
+
+// logFormat is the format of the messages, which are logged by the services
+// and by the Temporal SDK clients.
+var logFormat = LogFormatJSON
+
+// logLevel is the minimum level of the messages, which are logged by the
+// services and by the Temporal SDK clients. It defaults to info.
+var logLevel = new(slog.LevelVar)
+
+// SetLogLevel sets the minimum level of the messages, which are logged by the
+// services and by the Temporal SDK clients, e.g. to the level of the provider.
+func SetLogLevel(level slog.Level) {
+	logLevel.Set(level)
+}
+
+// SetLogFormat sets the format of the messages, which are logged by the
+// services and by the Temporal SDK clients, i.e. LogFormatJSON or LogFormatText.
+func SetLogFormat(format string) {
+	logFormat = format
+}
+
+// NewLogger returns the logger of a service, which is also passed to the
+// Temporal SDK client.
+func NewLogger() *slog.Logger {
+	options := &slog.HandlerOptions{
+		AddSource: true,
+		Level:     logLevel,
+	}
+
+	if logFormat == LogFormatText {
+		return slog.New(slog.NewTextHandler(os.Stdout, options))
+	}
+	return slog.New(slog.NewJSONHandler(os.Stdout, options))
+}
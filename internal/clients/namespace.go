@@ -2,32 +2,46 @@ package clients
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	enums "go.temporal.io/api/enums/v1"
 	ns "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/operatorservice/v1"
+	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/clients/compare"
 )
 
 const (
 	day = time.Hour * 24
+
+	defaultWorkflowExecutionRetention = 30 * day
 )
 
 type NamespaceService interface {
 	DescribeNamespaceByName(ctx context.Context, name string) (*core.TemporalNamespaceObservation, error)
+	DescribeNamespaceById(ctx context.Context, id string) (*core.TemporalNamespaceObservation, error)
 
 	CreateNamespace(ctx context.Context, namespace *core.TemporalNamespaceParameters) error
 	UpdateNamespaceByName(ctx context.Context, namespace *core.TemporalNamespaceParameters) error
 	DeleteNamespaceByName(ctx context.Context, name string) (*string, error)
 
+	ListAllNamespaces(ctx context.Context) ([]*core.TemporalNamespaceObservation, error)
+
 	MapToNamespaceCompare(namespace interface{}) (*NamespaceCompare, error)
 
+	HostPort() string
+	TLSCertificates() *TLSCertificates
+
 	Close()
 }
 
@@ -36,30 +50,211 @@ type NamespaceCompare struct {
 	Description                    *string            `json:"description,omitempty"`
 	OwnerEmail                     *string            `json:"ownerEmail,omitempty"`
 	WorkflowExecutionRetentionDays int                `json:"workflowExecutionRetentionDays,omitempty"`
+	WorkflowExecutionRetention     *metav1.Duration   `json:"workflowExecutionRetention,omitempty"`
 	Data                           *map[string]string `json:"data,omitempty"`
 	HistoryArchivalState           string             `json:"historyArchivalState,omitempty"`
 	HistoryArchivalUri             *string            `json:"historyArchivalUri,omitempty"`
 	VisibilityArchivalState        string             `json:"visibilityArchivalState,omitempty"`
 	VisibilityArchivalUri          *string            `json:"visibilityArchivalUri,omitempty"`
+	CustomSearchAttributeAliases   *map[string]string `json:"customSearchAttributeAliases,omitempty"`
+	IsGlobalNamespace              bool               `json:"isGlobalNamespace,omitempty"`
+	Clusters                       []string           `json:"clusters,omitempty"`
+	ActiveClusterName              *string            `json:"activeClusterName,omitempty"`
 }
 
+// archivalStates are the archival states, which can be specified.
+var archivalStates = []string{"Disabled", "Enabled"}
+
+// MapToNamespaceCompare maps the parameters or the observation of a namespace
+// field by field and normalizes the fields, which Temporal might represent
+// differently than the spec.
 func (s *TemporalServiceImpl) MapToNamespaceCompare(namespace interface{}) (*NamespaceCompare, error) {
-	namespaceJson, err := json.Marshal(namespace)
-	if err != nil {
-		return nil, err
+	var namespaceCompare NamespaceCompare
+
+	switch n := namespace.(type) {
+	case *core.TemporalNamespaceParameters:
+		namespaceCompare = NamespaceCompare{
+			Name:                           n.Name,
+			Description:                    n.Description,
+			OwnerEmail:                     n.OwnerEmail,
+			WorkflowExecutionRetentionDays: n.WorkflowExecutionRetentionDays,
+			WorkflowExecutionRetention:     n.WorkflowExecutionRetention,
+			Data:                           n.Data,
+			HistoryArchivalState:           n.HistoryArchivalState,
+			HistoryArchivalUri:             n.HistoryArchivalUri,
+			VisibilityArchivalState:        n.VisibilityArchivalState,
+			VisibilityArchivalUri:          n.VisibilityArchivalUri,
+			CustomSearchAttributeAliases:   n.CustomSearchAttributeAliases,
+			IsGlobalNamespace:              n.IsGlobalNamespace,
+			Clusters:                       n.Clusters,
+			ActiveClusterName:              n.ActiveClusterName,
+		}
+	case *core.TemporalNamespaceObservation:
+		namespaceCompare = NamespaceCompare{
+			Name:                           n.Name,
+			Description:                    n.Description,
+			OwnerEmail:                     n.OwnerEmail,
+			WorkflowExecutionRetentionDays: n.WorkflowExecutionRetentionDays,
+			WorkflowExecutionRetention:     n.WorkflowExecutionRetention,
+			Data:                           n.Data,
+			HistoryArchivalState:           n.HistoryArchivalState,
+			HistoryArchivalUri:             n.HistoryArchivalUri,
+			VisibilityArchivalState:        n.VisibilityArchivalState,
+			VisibilityArchivalUri:          n.VisibilityArchivalUri,
+			CustomSearchAttributeAliases:   n.CustomSearchAttributeAliases,
+			IsGlobalNamespace:              n.IsGlobalNamespace,
+			Clusters:                       n.Clusters,
+			ActiveClusterName:              n.ActiveClusterName,
+		}
+	default:
+		return nil, fmt.Errorf("cannot compare %T as namespace", namespace)
 	}
 
-	var namespaceCompare = NamespaceCompare{}
-	err = json.Unmarshal(namespaceJson, &namespaceCompare)
-	if err != nil {
-		return nil, err
+	return normalizeNamespaceCompare(namespaceCompare), nil
+}
+
+func normalizeNamespaceCompare(n NamespaceCompare) *NamespaceCompare {
+	return &NamespaceCompare{
+		Name:        n.Name,
+		Description: compare.String(n.Description),
+		OwnerEmail:  compare.String(n.OwnerEmail),
+
+		// The retention is compared as duration, regardless whether it is
+		// specified in days or as duration
+		WorkflowExecutionRetention: &metav1.Duration{
+			Duration: resolveWorkflowExecutionRetention(n.WorkflowExecutionRetentionDays, n.WorkflowExecutionRetention),
+		},
+
+		Data:                         compare.MapPtr(n.Data),
+		HistoryArchivalState:         compare.Enum(n.HistoryArchivalState, archivalStates...),
+		HistoryArchivalUri:           compare.String(n.HistoryArchivalUri),
+		VisibilityArchivalState:      compare.Enum(n.VisibilityArchivalState, archivalStates...),
+		VisibilityArchivalUri:        compare.String(n.VisibilityArchivalUri),
+		CustomSearchAttributeAliases: compare.MapPtr(n.CustomSearchAttributeAliases),
+		IsGlobalNamespace:            n.IsGlobalNamespace,
+		Clusters:                     compare.Strings(n.Clusters),
+		ActiveClusterName:            compare.String(n.ActiveClusterName),
+	}
+}
+
+// IgnoreUnmanagedConfig removes the custom search attribute aliases, the
+// clusters and the active cluster from the observed namespace, if they are not
+// managed by the spec
+func IgnoreUnmanagedConfig(spec *NamespaceCompare, observed *NamespaceCompare) {
+	if spec.CustomSearchAttributeAliases == nil {
+		observed.CustomSearchAttributeAliases = nil
+	} else if observed.CustomSearchAttributeAliases == nil {
+		observed.CustomSearchAttributeAliases = &map[string]string{}
+	}
+
+	if len(spec.Clusters) == 0 {
+		observed.Clusters = nil
+	}
+
+	if spec.ActiveClusterName == nil {
+		observed.ActiveClusterName = nil
+	}
+}
+
+// IgnoreUnmanagedDataKeys removes the keys from the observed data, which are
+// neither set in the spec nor were managed before. Managed keys with an empty
+// value are regarded as removed.
+func IgnoreUnmanagedDataKeys(spec *NamespaceCompare, observed *NamespaceCompare, managedKeys []string) {
+	if observed.Data == nil {
+		return
+	}
+
+	managed := map[string]bool{}
+	for _, key := range managedKeys {
+		managed[key] = true
+	}
+
+	var specData map[string]string
+	if spec.Data != nil {
+		specData = *spec.Data
+	}
+
+	data := map[string]string{}
+	for key, value := range *observed.Data {
+		_, inSpec := specData[key]
+		if inSpec || (managed[key] && value != "") {
+			data[key] = value
+		}
+	}
+
+	observed.Data = nil
+	if len(data) > 0 || spec.Data != nil {
+		observed.Data = &data
+	}
+}
+
+// IgnoreClearedDataKeys removes the keys from the observed data, which are
+// not set in the spec and whose value is empty. Temporal does not remove keys
+// from the data, therefore keys, which are not in the spec, are cleared and
+// regarded as removed.
+func IgnoreClearedDataKeys(spec *NamespaceCompare, observed *NamespaceCompare) {
+	if observed.Data == nil {
+		return
+	}
+
+	var specData map[string]string
+	if spec.Data != nil {
+		specData = *spec.Data
+	}
+
+	data := map[string]string{}
+	for key, value := range *observed.Data {
+		if _, inSpec := specData[key]; inSpec || value != "" {
+			data[key] = value
+		}
+	}
+
+	observed.Data = nil
+	if len(data) > 0 || spec.Data != nil {
+		observed.Data = &data
 	}
+}
 
-	return &namespaceCompare, nil
+// IsRetentionRejected returns true, if the server rejected the retention of
+// a namespace, e.g. because it is not within the allowed range.
+func IsRetentionRejected(err error) bool {
+	var invalidArgument *serviceerror.InvalidArgument
+	return errors.As(err, &invalidArgument) && strings.Contains(strings.ToLower(invalidArgument.Error()), "retention")
+}
+
+// IgnoreImmutableArchivalUris keeps the observed archival URIs in the spec,
+// because Temporal rejects changes of archival URIs once they are set. It
+// returns the fields, whose changes are ignored.
+func IgnoreImmutableArchivalUris(spec *NamespaceCompare, observed *NamespaceCompare) []string {
+	var ignored []string
+	if archivalUriChanged(spec.HistoryArchivalUri, observed.HistoryArchivalUri) {
+		spec.HistoryArchivalUri = observed.HistoryArchivalUri
+		ignored = append(ignored, "historyArchivalUri")
+	}
+
+	if archivalUriChanged(spec.VisibilityArchivalUri, observed.VisibilityArchivalUri) {
+		spec.VisibilityArchivalUri = observed.VisibilityArchivalUri
+		ignored = append(ignored, "visibilityArchivalUri")
+	}
+
+	return ignored
+}
+
+func archivalUriChanged(spec *string, observed *string) bool {
+	return observed != nil && resolvePtrOrDefault(spec) != *observed
+}
+
+// resolveArchivalUri returns the observed archival URI, if it is already set,
+// because it can not be changed anymore
+func resolveArchivalUri(spec *string, observed *string) string {
+	if observed != nil {
+		return *observed
+	}
+	return resolvePtrOrDefault(spec)
 }
 
 func (s *TemporalServiceImpl) CreateNamespace(ctx context.Context, namespace *core.TemporalNamespaceParameters) error {
-	retentionDuration := time.Duration(namespace.WorkflowExecutionRetentionDays) * day
+	retentionDuration := resolveWorkflowExecutionRetention(namespace.WorkflowExecutionRetentionDays, namespace.WorkflowExecutionRetention)
 
 	var data map[string]string
 	if namespace.Data != nil {
@@ -76,6 +271,9 @@ func (s *TemporalServiceImpl) CreateNamespace(ctx context.Context, namespace *co
 		HistoryArchivalUri:               resolvePtrOrDefault(namespace.HistoryArchivalUri),
 		VisibilityArchivalState:          enums.ArchivalState(enums.ArchivalState_value[namespace.VisibilityArchivalState]),
 		VisibilityArchivalUri:            resolvePtrOrDefault(namespace.VisibilityArchivalUri),
+		IsGlobalNamespace:                namespace.IsGlobalNamespace,
+		Clusters:                         mapToClusterReplicationConfigs(namespace.Clusters),
+		ActiveClusterName:                resolvePtrOrDefault(namespace.ActiveClusterName),
 	}
 
 	_, err := s.client.WorkflowService().RegisterNamespace(ctx, createrequest)
@@ -83,6 +281,9 @@ func (s *TemporalServiceImpl) CreateNamespace(ctx context.Context, namespace *co
 
 	if errors.As(err, &namespaceAlreadyExists) {
 		s.logger.Debug("Namespace '" + namespace.Name + "' already exists. " + err.Error())
+		if namespace.AdoptionPolicy == core.AdoptionPolicyStrict {
+			return err
+		}
 		return nil
 	}
 
@@ -135,6 +336,32 @@ func (s *TemporalServiceImpl) DescribeNamespaceByName(ctx context.Context, name
 	return mapDescribeNamespaceResponse(response), nil
 }
 
+// DescribeNamespaceById describes the namespace by its id. In contrast to the
+// name, the id is kept while a deleted namespace is reclaimed.
+func (s *TemporalServiceImpl) DescribeNamespaceById(ctx context.Context, id string) (*core.TemporalNamespaceObservation, error) {
+	request := &workflowservice.DescribeNamespaceRequest{
+		Id: id,
+	}
+
+	response, err := s.client.WorkflowService().DescribeNamespace(ctx, request)
+
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &namespaceNotFound) {
+		s.logger.Debug("Namespace with id '" + id + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil {
+		return nil, nil
+	}
+
+	return mapDescribeNamespaceResponse(response), nil
+}
+
 func (s *TemporalServiceImpl) DeleteNamespaceByName(ctx context.Context, name string) (*string, error) {
 	deleterequest := &operatorservice.DeleteNamespaceRequest{
 		Namespace: name,
@@ -142,7 +369,7 @@ func (s *TemporalServiceImpl) DeleteNamespaceByName(ctx context.Context, name st
 
 	namespace, err := s.DescribeNamespaceByName(ctx, name)
 	if namespace != nil {
-		response, err := s.client.OperatorService().DeleteNamespace(ctx, deleterequest)
+		response, err := s.operatorService().DeleteNamespace(ctx, deleterequest)
 
 		var namespaceInvalidState *serviceerror.NamespaceInvalidState
 		if errors.As(err, &namespaceInvalidState) {
@@ -177,70 +404,111 @@ func mapDescribeNamespaceResponse(response *workflowservice.DescribeNamespaceRes
 		data = &response.NamespaceInfo.Data
 	}
 
+	var aliases *map[string]string = nil
+	if len(response.Config.CustomSearchAttributeAliases) > 0 {
+		aliases = &response.Config.CustomSearchAttributeAliases
+	}
+
 	return &core.TemporalNamespaceObservation{
 		Id:                             response.NamespaceInfo.Id,
 		Name:                           response.NamespaceInfo.Name,
 		Description:                    createPtrOrNilIfDefault(response.NamespaceInfo.Description),
 		OwnerEmail:                     createPtrOrNilIfDefault(response.NamespaceInfo.OwnerEmail),
-		WorkflowExecutionRetentionDays: int(*response.Config.WorkflowExecutionRetentionTtl / day),
+		WorkflowExecutionRetentionDays: retentionInDays(response.Config.GetWorkflowExecutionRetentionTtl()),
+		WorkflowExecutionRetention:     createDurationPtrOrNilIfDefault(response.Config.WorkflowExecutionRetentionTtl),
 		Data:                           data,
 		HistoryArchivalState:           response.Config.HistoryArchivalState.String(),
 		HistoryArchivalUri:             createPtrOrNilIfDefault(response.Config.HistoryArchivalUri),
 		VisibilityArchivalState:        response.Config.VisibilityArchivalState.String(),
 		VisibilityArchivalUri:          createPtrOrNilIfDefault(response.Config.VisibilityArchivalUri),
+		CustomSearchAttributeAliases:   aliases,
+		IsGlobalNamespace:              response.GetIsGlobalNamespace(),
+		Clusters:                       mapFromClusterReplicationConfigs(response.GetReplicationConfig().GetClusters()),
+		ActiveClusterName:              createPtrOrNilIfDefault(response.GetReplicationConfig().GetActiveClusterName()),
+		FailoverVersion:                response.GetFailoverVersion(),
+		ReplicationState:               response.GetReplicationConfig().GetState().String(),
 		State:                          response.NamespaceInfo.State.String(),
 	}
 }
 
-func (s *TemporalServiceImpl) ListAllNamespaces(ctx context.Context) ([]*core.TemporalNamespaceObservation, error) {
-	// TODO: Pagination (method only used in tests)
-	request := &workflowservice.ListNamespacesRequest{
-		PageSize: 100,
+func mapToClusterReplicationConfigs(clusters []string) []*replicationpb.ClusterReplicationConfig {
+	var configs []*replicationpb.ClusterReplicationConfig
+	for _, cluster := range clusters {
+		configs = append(configs, &replicationpb.ClusterReplicationConfig{
+			ClusterName: cluster,
+		})
 	}
+	return configs
+}
 
-	responses, err := s.client.WorkflowService().ListNamespaces(ctx, request)
-	if err != nil {
-		return nil, err
+func mapFromClusterReplicationConfigs(configs []*replicationpb.ClusterReplicationConfig) []string {
+	var clusters []string
+	for _, config := range configs {
+		clusters = append(clusters, config.GetClusterName())
 	}
+	return clusters
+}
 
+func (s *TemporalServiceImpl) ListAllNamespaces(ctx context.Context) ([]*core.TemporalNamespaceObservation, error) {
 	var namespaces = []*core.TemporalNamespaceObservation{}
-	for _, response := range responses.Namespaces {
-		namespace := mapDescribeNamespaceResponse(response)
-		if namespace.Name != "temporal-system" && namespace.State != "Deleted" {
-			namespaces = append(namespaces, namespace)
+	var nextPageToken []byte
+	for {
+		request := &workflowservice.ListNamespacesRequest{
+			PageSize:      100,
+			NextPageToken: nextPageToken,
 		}
-	}
 
-	return namespaces, nil
+		responses, err := s.client.WorkflowService().ListNamespaces(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, response := range responses.Namespaces {
+			namespace := mapDescribeNamespaceResponse(response)
+			if namespace.Name != "temporal-system" && namespace.State != "Deleted" {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+
+		nextPageToken = responses.NextPageToken
+		if len(nextPageToken) == 0 {
+			return namespaces, nil
+		}
+	}
 }
 
 func (s *TemporalServiceImpl) UpdateNamespaceByName(ctx context.Context, namespace *core.TemporalNamespaceParameters) error {
+	observed, err := s.DescribeNamespaceByName(ctx, namespace.Name)
+	if err != nil {
+		return err
+	}
 
-	retentionTtl := time.Duration(namespace.WorkflowExecutionRetentionDays * int(day))
+	if observed == nil {
+		return errors.New("Namespace '" + namespace.Name + "' not found")
+	}
 
-	var data map[string]string
-	if namespace.Data != nil {
-		data = *namespace.Data
+	updaterequest := mapToUpdateNamespaceRequest(namespace, observed)
+	if updaterequest != nil {
+		_, err = s.client.WorkflowService().UpdateNamespace(ctx, updaterequest)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Temporal rejects a failover combined with other changes, therefore the
+	// active cluster is changed by a separate request
+	if namespace.ActiveClusterName == nil || resolvePtrOrDefault(observed.ActiveClusterName) == *namespace.ActiveClusterName {
+		return nil
 	}
 
-	updaterequest := &workflowservice.UpdateNamespaceRequest{
+	failoverrequest := &workflowservice.UpdateNamespaceRequest{
 		Namespace: namespace.Name,
-		UpdateInfo: &ns.UpdateNamespaceInfo{
-			Description: resolvePtrOrDefault(namespace.Description),
-			OwnerEmail:  resolvePtrOrDefault(namespace.OwnerEmail),
-			Data:        data,
-		},
-		Config: &ns.NamespaceConfig{
-			HistoryArchivalState:          enums.ArchivalState(enums.ArchivalState_value[namespace.HistoryArchivalState]),
-			HistoryArchivalUri:            resolvePtrOrDefault(namespace.HistoryArchivalUri),
-			VisibilityArchivalState:       enums.ArchivalState(enums.ArchivalState_value[namespace.VisibilityArchivalState]),
-			VisibilityArchivalUri:         resolvePtrOrDefault(namespace.VisibilityArchivalUri),
-			WorkflowExecutionRetentionTtl: &retentionTtl,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: *namespace.ActiveClusterName,
 		},
 	}
 
-	_, err := s.client.WorkflowService().UpdateNamespace(ctx, updaterequest)
-
+	_, err = s.client.WorkflowService().UpdateNamespace(ctx, failoverrequest)
 	if err != nil {
 		return err
 	}
@@ -248,6 +516,155 @@ func (s *TemporalServiceImpl) UpdateNamespaceByName(ctx context.Context, namespa
 	return nil
 }
 
+// mapToUpdateNamespaceRequest returns a request, which only contains the
+// fields that differ from the observed namespace. Fields, which are not set,
+// are not changed by Temporal. If nothing differs, nil is returned.
+func mapToUpdateNamespaceRequest(namespace *core.TemporalNamespaceParameters, observed *core.TemporalNamespaceObservation) *workflowservice.UpdateNamespaceRequest {
+	changed := false
+
+	updateInfo := &ns.UpdateNamespaceInfo{}
+	if description := resolvePtrOrDefault(namespace.Description); description != resolvePtrOrDefault(observed.Description) {
+		updateInfo.Description = description
+		changed = true
+	}
+
+	if ownerEmail := resolvePtrOrDefault(namespace.OwnerEmail); ownerEmail != resolvePtrOrDefault(observed.OwnerEmail) {
+		updateInfo.OwnerEmail = ownerEmail
+		changed = true
+	}
+
+	var data map[string]string
+	if namespace.Data != nil {
+		data = *namespace.Data
+	}
+
+	if changedData := changedValues(data, observed.Data); len(changedData) > 0 {
+		updateInfo.Data = changedData
+		changed = true
+	}
+
+	config := &ns.NamespaceConfig{}
+	retentionTtl := resolveWorkflowExecutionRetention(namespace.WorkflowExecutionRetentionDays, namespace.WorkflowExecutionRetention)
+	if observed.WorkflowExecutionRetention == nil || observed.WorkflowExecutionRetention.Duration != retentionTtl {
+		config.WorkflowExecutionRetentionTtl = &retentionTtl
+		changed = true
+	}
+
+	if namespace.HistoryArchivalState != observed.HistoryArchivalState {
+		config.HistoryArchivalState = enums.ArchivalState(enums.ArchivalState_value[namespace.HistoryArchivalState])
+		changed = true
+	}
+
+	if uri := resolveArchivalUri(namespace.HistoryArchivalUri, observed.HistoryArchivalUri); uri != resolvePtrOrDefault(observed.HistoryArchivalUri) {
+		config.HistoryArchivalUri = uri
+		changed = true
+	}
+
+	if namespace.VisibilityArchivalState != observed.VisibilityArchivalState {
+		config.VisibilityArchivalState = enums.ArchivalState(enums.ArchivalState_value[namespace.VisibilityArchivalState])
+		changed = true
+	}
+
+	if uri := resolveArchivalUri(namespace.VisibilityArchivalUri, observed.VisibilityArchivalUri); uri != resolvePtrOrDefault(observed.VisibilityArchivalUri) {
+		config.VisibilityArchivalUri = uri
+		changed = true
+	}
+
+	aliases := mapToCustomSearchAttributeAliases(namespace.CustomSearchAttributeAliases, observed.CustomSearchAttributeAliases)
+	if changedAliases := changedValues(aliases, observed.CustomSearchAttributeAliases); len(changedAliases) > 0 {
+		config.CustomSearchAttributeAliases = changedAliases
+		changed = true
+	}
+
+	var replicationConfig *replicationpb.NamespaceReplicationConfig
+	if len(namespace.Clusters) > 0 && !equalClusters(namespace.Clusters, observed.Clusters) {
+		replicationConfig = &replicationpb.NamespaceReplicationConfig{
+			Clusters: mapToClusterReplicationConfigs(namespace.Clusters),
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return &workflowservice.UpdateNamespaceRequest{
+		Namespace:         namespace.Name,
+		UpdateInfo:        updateInfo,
+		Config:            config,
+		ReplicationConfig: replicationConfig,
+	}
+}
+
+// changedValues returns the entries, which are missing or have another value
+// in the observed map
+func changedValues(values map[string]string, observed *map[string]string) map[string]string {
+	var observedValues map[string]string
+	if observed != nil {
+		observedValues = *observed
+	}
+
+	changed := map[string]string{}
+	for key, value := range values {
+		if observedValue, ok := observedValues[key]; !ok || observedValue != value {
+			changed[key] = value
+		}
+	}
+	return changed
+}
+
+func equalClusters(clusters []string, observed []string) bool {
+	sortedClusters := append([]string{}, clusters...)
+	sortedObserved := append([]string{}, observed...)
+	sort.Strings(sortedClusters)
+	sort.Strings(sortedObserved)
+	return strings.Join(sortedClusters, ",") == strings.Join(sortedObserved, ",")
+}
+
+// mapToCustomSearchAttributeAliases returns the aliases of the spec. Observed
+// aliases, which are not part of the spec, are removed by an empty alias.
+func mapToCustomSearchAttributeAliases(aliases *map[string]string, observed *map[string]string) map[string]string {
+	if aliases == nil {
+		return nil
+	}
+
+	result := map[string]string{}
+	if observed != nil {
+		for field := range *observed {
+			result[field] = ""
+		}
+	}
+
+	for field, alias := range *aliases {
+		result[field] = alias
+	}
+	return result
+}
+
+// retentionInDays returns the retention in whole days, which is only
+// informative. The retention is compared as duration, because a retention like
+// 36h can not be represented in days
+func retentionInDays(retention *time.Duration) int {
+	if retention == nil {
+		return 0
+	}
+	return int(*retention / day)
+}
+
+// resolveWorkflowExecutionRetention returns the retention, which is either
+// specified in days or as duration
+func resolveWorkflowExecutionRetention(days int, retention *metav1.Duration) time.Duration {
+	if retention != nil {
+		return retention.Duration
+	}
+
+	if days > 0 {
+		return time.Duration(days) * day
+	}
+
+	return defaultWorkflowExecutionRetention
+}
+
 func resolvePtrOrDefault(ptr *string) string {
 	if ptr == nil {
 		return ""
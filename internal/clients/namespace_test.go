@@ -4,8 +4,13 @@ import (
 	"encoding/json"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	ns "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"golang.org/x/net/context"
 
@@ -313,6 +318,8 @@ func assertNamespaceAreEqual(t *testing.T, temporalService NamespaceService, act
 		t.Fatal(err)
 	}
 
+	IgnoreUnmanagedConfig(mappedExpected, mappedActual)
+
 	diff := cmp.Diff(mappedActual, mappedExpected)
 	if diff != "" {
 		t.Fatal(diff)
@@ -340,3 +347,453 @@ func skipIfIsShort(t *testing.T) {
 		t.Skip("skipping test in short mode.")
 	}
 }
+
+func TestCreateWithReplicationConfig(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalNamespaceService(t)
+	activeCluster := "active"
+	testNamespace := createDefaultNamespaceParametersWithName("Test008")
+	testNamespace.Clusters = []string{activeCluster}
+	testNamespace.ActiveClusterName = &activeCluster
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := temporalService.DescribeNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertNamespaceAreEqual(t, temporalService, created, testNamespace)
+
+	err = temporalService.UpdateNamespaceByName(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := temporalService.DescribeNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertNamespaceAreEqual(t, temporalService, updated, testNamespace)
+
+	_, err = temporalService.DeleteNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertNamespacesCount(t, temporalService, 0)
+}
+
+func TestCreateWithRetentionDuration(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalNamespaceService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test009")
+	testNamespace.WorkflowExecutionRetentionDays = 0
+	testNamespace.WorkflowExecutionRetention = &metav1.Duration{Duration: 36 * time.Hour}
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := temporalService.DescribeNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertNamespaceAreEqual(t, temporalService, created, testNamespace)
+
+	_, err = temporalService.DeleteNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertNamespacesCount(t, temporalService, 0)
+}
+
+func TestCompareRetentionInDaysAndAsDuration(t *testing.T) {
+	temporalService := &TemporalServiceImpl{}
+
+	inDays, err := temporalService.MapToNamespaceCompare(&core.TemporalNamespaceParameters{Name: "Test", WorkflowExecutionRetentionDays: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	asDuration, err := temporalService.MapToNamespaceCompare(&core.TemporalNamespaceParameters{Name: "Test", WorkflowExecutionRetention: &metav1.Duration{Duration: 72 * time.Hour}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(inDays, asDuration); diff != "" {
+		t.Fatal(diff)
+	}
+
+	unset, err := temporalService.MapToNamespaceCompare(&core.TemporalNamespaceParameters{Name: "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if unset.WorkflowExecutionRetention.Duration != defaultWorkflowExecutionRetention {
+		t.Fatalf("expected default retention, got %s", unset.WorkflowExecutionRetention.Duration)
+	}
+}
+
+func TestCompareObservedRetentionAsDuration(t *testing.T) {
+	temporalService := &TemporalServiceImpl{}
+
+	retention := 36 * time.Hour
+	observation := mapDescribeNamespaceResponse(&workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &ns.NamespaceInfo{Name: "Test"},
+		Config:        &ns.NamespaceConfig{WorkflowExecutionRetentionTtl: &retention},
+	})
+
+	if observation.WorkflowExecutionRetentionDays != 1 {
+		t.Fatalf("expected 1 day, got %d", observation.WorkflowExecutionRetentionDays)
+	}
+
+	observed, err := temporalService.MapToNamespaceCompare(observation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	asDuration, err := temporalService.MapToNamespaceCompare(&core.TemporalNamespaceParameters{Name: "Test", WorkflowExecutionRetention: &metav1.Duration{Duration: retention}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if observed.WorkflowExecutionRetention.Duration != asDuration.WorkflowExecutionRetention.Duration {
+		t.Fatalf("expected retention %s, got %s", asDuration.WorkflowExecutionRetention.Duration, observed.WorkflowExecutionRetention.Duration)
+	}
+
+	inDays, err := temporalService.MapToNamespaceCompare(&core.TemporalNamespaceParameters{Name: "Test", WorkflowExecutionRetentionDays: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if observed.WorkflowExecutionRetention.Duration == inDays.WorkflowExecutionRetention.Duration {
+		t.Fatalf("expected retention %s to differ from 1 day", observed.WorkflowExecutionRetention.Duration)
+	}
+}
+
+func TestCompareNamespaceDrift(t *testing.T) {
+	temporalService := &TemporalServiceImpl{}
+
+	description := "Test namespace"
+	changedDescription := "Changed namespace"
+	paddedDescription := " Test namespace\n"
+	email := "test@example.com"
+
+	cases := map[string]struct {
+		spec     *core.TemporalNamespaceParameters
+		observed *core.TemporalNamespaceObservation
+		drift    bool
+	}{
+		"Equal": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", Description: &description, OwnerEmail: &email, HistoryArchivalState: "Disabled"},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", Description: &description, OwnerEmail: &email, HistoryArchivalState: "Disabled"},
+		},
+		"DescriptionWithWhiteSpace": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", Description: &paddedDescription},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", Description: &description},
+		},
+		"DescriptionChanged": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", Description: &changedDescription},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", Description: &description},
+			drift:    true,
+		},
+		"ArchivalStateCase": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", VisibilityArchivalState: "enabled"},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", VisibilityArchivalState: "Enabled"},
+		},
+		"ArchivalStateChanged": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", VisibilityArchivalState: "Enabled"},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", VisibilityArchivalState: "Disabled"},
+			drift:    true,
+		},
+		"ClustersInOtherOrder": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", Clusters: []string{"b", "a"}},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", Clusters: []string{"a", "b"}},
+		},
+		"ClusterAdded": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", Clusters: []string{"a", "b"}},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", Clusters: []string{"a"}},
+			drift:    true,
+		},
+		"DataChanged": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", Data: &map[string]string{"team": "a"}},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", Data: &map[string]string{"team": "b"}},
+			drift:    true,
+		},
+		"RetentionInDays": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", WorkflowExecutionRetentionDays: 2},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", WorkflowExecutionRetention: &metav1.Duration{Duration: 48 * time.Hour}},
+		},
+		"RetentionChanged": {
+			spec:     &core.TemporalNamespaceParameters{Name: "Test", WorkflowExecutionRetentionDays: 3},
+			observed: &core.TemporalNamespaceObservation{Name: "Test", WorkflowExecutionRetention: &metav1.Duration{Duration: 48 * time.Hour}},
+			drift:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := temporalService.MapToNamespaceCompare(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			observed, err := temporalService.MapToNamespaceCompare(tc.observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(spec, observed); (diff != "") != tc.drift {
+				t.Fatalf("expected drift %t, got diff %s", tc.drift, diff)
+			}
+		})
+	}
+}
+
+func TestMapToNamespaceCompareCopiesFields(t *testing.T) {
+	temporalService := &TemporalServiceImpl{}
+
+	description := "Test namespace"
+	data := map[string]string{"team": "a"}
+	spec := &core.TemporalNamespaceParameters{Name: "Test", Description: &description, Data: &data, Clusters: []string{"b", "a"}}
+
+	mapped, err := temporalService.MapToNamespaceCompare(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	*mapped.Description = "Changed"
+	(*mapped.Data)["team"] = "b"
+
+	if description != "Test namespace" || data["team"] != "a" || spec.Clusters[0] != "b" {
+		t.Fatalf("expected spec not to be changed, got %+v", spec)
+	}
+}
+
+func TestMapToNamespaceCompareUnexpectedType(t *testing.T) {
+	temporalService := &TemporalServiceImpl{}
+
+	if _, err := temporalService.MapToNamespaceCompare(&core.SearchAttributeParameters{Name: "Test"}); err == nil {
+		t.Fatal("expected error for unexpected type")
+	}
+}
+
+func TestMapToCustomSearchAttributeAliases(t *testing.T) {
+	aliases := map[string]string{"Keyword01": "CustomerId"}
+	observed := map[string]string{"Keyword01": "OrderId", "Int01": "Amount"}
+
+	mapped := mapToCustomSearchAttributeAliases(&aliases, &observed)
+
+	expected := map[string]string{"Keyword01": "CustomerId", "Int01": ""}
+	if diff := cmp.Diff(expected, mapped); diff != "" {
+		t.Fatal(diff)
+	}
+
+	if unmanaged := mapToCustomSearchAttributeAliases(nil, &observed); unmanaged != nil {
+		t.Fatalf("expected no aliases, got %v", unmanaged)
+	}
+}
+
+func TestDescribeDeletedNamespaceById(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalNamespaceService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test011")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := temporalService.DescribeNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byId, err := temporalService.DescribeNamespaceById(context.Background(), created.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if byId == nil || byId.Name != testNamespace.Name {
+		t.Fatalf("Expected namespace %s, got %v", testNamespace.Name, byId)
+	}
+
+	_, err = temporalService.DeleteNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := temporalService.DescribeNamespaceById(context.Background(), created.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deleted != nil && deleted.State != "Deleted" {
+		t.Fatalf("Expected reclaimed or deleted namespace, got %v", deleted)
+	}
+}
+
+func TestIgnoreImmutableArchivalUris(t *testing.T) {
+	observedUri := "file:///tmp/history"
+	changedUri := "file:///tmp/changed"
+	visibilityUri := "file:///tmp/visibility"
+
+	spec := &NamespaceCompare{HistoryArchivalUri: &changedUri, VisibilityArchivalUri: &visibilityUri}
+	observed := &NamespaceCompare{HistoryArchivalUri: &observedUri}
+
+	ignored := IgnoreImmutableArchivalUris(spec, observed)
+
+	if diff := cmp.Diff([]string{"historyArchivalUri"}, ignored); diff != "" {
+		t.Fatal(diff)
+	}
+
+	if *spec.HistoryArchivalUri != observedUri || *spec.VisibilityArchivalUri != visibilityUri {
+		t.Fatalf("expected observed history archival URI and unchanged visibility archival URI, got %v", spec)
+	}
+}
+
+func TestIgnoreUnmanagedDataKeys(t *testing.T) {
+	spec := &NamespaceCompare{Data: &map[string]string{"team": "a"}}
+	observed := &NamespaceCompare{Data: &map[string]string{"team": "b", "foreign": "x", "removed": "y", "cleared": ""}}
+
+	IgnoreUnmanagedDataKeys(spec, observed, []string{"team", "removed", "cleared"})
+
+	expected := &map[string]string{"team": "b", "removed": "y"}
+	if diff := cmp.Diff(expected, observed.Data); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestIgnoreClearedDataKeys(t *testing.T) {
+	spec := &NamespaceCompare{Data: &map[string]string{"team": "a", "cleared": ""}}
+	observed := &NamespaceCompare{Data: &map[string]string{"team": "a", "foreign": "x", "removed": "", "cleared": ""}}
+
+	IgnoreClearedDataKeys(spec, observed)
+
+	expected := &map[string]string{"team": "a", "foreign": "x", "cleared": ""}
+	if diff := cmp.Diff(expected, observed.Data); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestDescribeReplicationOfLocalNamespace(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalNamespaceService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test012")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := temporalService.DescribeNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created.IsGlobalNamespace || resolvePtrOrDefault(created.ActiveClusterName) != "active" || len(created.Clusters) != 1 {
+		t.Fatalf("Expected local namespace replicated to cluster 'active', got %v", created)
+	}
+
+	if created.ReplicationState == "" {
+		t.Fatal("Expected replication state")
+	}
+
+	_, err = temporalService.DeleteNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateStrictAlreadyExists(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalNamespaceService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test013")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testNamespace.AdoptionPolicy = core.AdoptionPolicyStrict
+	err = temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err == nil {
+		t.Fatal("Expected error, because the namespace already exists")
+	}
+
+	_, err = temporalService.DeleteNamespaceByName(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsRetentionRejected(t *testing.T) {
+	if !IsRetentionRejected(serviceerror.NewInvalidArgument("A valid retention period is not set on request.")) {
+		t.Fatal("Expected rejected retention")
+	}
+
+	if IsRetentionRejected(serviceerror.NewInvalidArgument("Invalid owner email")) {
+		t.Fatal("Expected no rejected retention for other invalid arguments")
+	}
+
+	if IsRetentionRejected(nil) {
+		t.Fatal("Expected no rejected retention without error")
+	}
+}
+
+func TestMapToUpdateNamespaceRequestOnlyContainsChangedFields(t *testing.T) {
+	desc := "Desc1"
+	changedMail := "Changed@mail.local"
+	mail := "Test1@mail.local"
+	retention := metav1.Duration{Duration: 30 * day}
+
+	namespace := createDefaultNamespaceParametersWithName("Test")
+	namespace.OwnerEmail = &changedMail
+	namespace.Data = &map[string]string{"key1": "value1", "key2": "changed"}
+
+	observed := &core.TemporalNamespaceObservation{
+		Name:                       "Test",
+		Description:                &desc,
+		OwnerEmail:                 &mail,
+		WorkflowExecutionRetention: &retention,
+		Data:                       &map[string]string{"key1": "value1", "key2": "value2"},
+		HistoryArchivalState:       "Disabled",
+		VisibilityArchivalState:    "Disabled",
+	}
+
+	request := mapToUpdateNamespaceRequest(namespace, observed)
+	if request == nil {
+		t.Fatal("Expected update request")
+	}
+
+	if request.UpdateInfo.Description != "" || request.UpdateInfo.OwnerEmail != changedMail {
+		t.Fatalf("Expected only changed owner email, got %v", request.UpdateInfo)
+	}
+
+	if diff := cmp.Diff(map[string]string{"key2": "changed"}, request.UpdateInfo.Data); diff != "" {
+		t.Fatal(diff)
+	}
+
+	if request.Config.WorkflowExecutionRetentionTtl != nil || request.ReplicationConfig != nil {
+		t.Fatalf("Expected unchanged config, got %v", request.Config)
+	}
+
+	namespace.OwnerEmail = &mail
+	namespace.Data = observed.Data
+	if request := mapToUpdateNamespaceRequest(namespace, observed); request != nil {
+		t.Fatalf("Expected no update request, got %v", request)
+	}
+}
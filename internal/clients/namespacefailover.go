@@ -0,0 +1,92 @@
+package clients
+
+import (
+	"context"
+	"errors"
+
+	replicationpb "go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+type NamespaceFailoverService interface {
+	DescribeNamespaceFailover(ctx context.Context, namespace string) (*core.NamespaceFailoverObservation, error)
+
+	FailoverNamespace(ctx context.Context, failover *core.NamespaceFailoverParameters) error
+
+	Close()
+}
+
+func (s *TemporalServiceImpl) DescribeNamespaceFailover(ctx context.Context, namespace string) (*core.NamespaceFailoverObservation, error) {
+	request := &workflowservice.DescribeNamespaceRequest{
+		Namespace: namespace,
+	}
+
+	response, err := s.client.WorkflowService().DescribeNamespace(ctx, request)
+
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &namespaceNotFound) {
+		s.logger.Debug("Namespace '" + namespace + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mapNamespaceFailover(namespace, response), nil
+}
+
+// FailoverNamespace changes the active cluster of a global namespace.
+func (s *TemporalServiceImpl) FailoverNamespace(ctx context.Context, failover *core.NamespaceFailoverParameters) error {
+	observed, err := s.DescribeNamespaceFailover(ctx, *failover.TemporalNamespaceName)
+	if err != nil {
+		return err
+	}
+
+	if observed == nil {
+		return errors.New("Namespace '" + *failover.TemporalNamespaceName + "' not found")
+	}
+
+	if !observed.IsGlobalNamespace {
+		return errors.New("Namespace '" + *failover.TemporalNamespaceName + "' is not a global namespace and can not be failed over")
+	}
+
+	request := &workflowservice.UpdateNamespaceRequest{
+		Namespace: *failover.TemporalNamespaceName,
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+			ActiveClusterName: failover.ActiveClusterName,
+		},
+	}
+
+	_, err = s.client.WorkflowService().UpdateNamespace(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func mapNamespaceFailover(namespace string, response *workflowservice.DescribeNamespaceResponse) *core.NamespaceFailoverObservation {
+	observation := &core.NamespaceFailoverObservation{
+		TemporalNamespaceName: namespace,
+		ActiveClusterName:     response.GetReplicationConfig().GetActiveClusterName(),
+		IsGlobalNamespace:     response.GetIsGlobalNamespace(),
+		FailoverVersion:       response.GetFailoverVersion(),
+	}
+
+	for _, cluster := range response.GetReplicationConfig().GetClusters() {
+		observation.Clusters = append(observation.Clusters, cluster.GetClusterName())
+	}
+
+	for _, failover := range response.GetFailoverHistory() {
+		observation.FailoverHistory = append(observation.FailoverHistory, core.NamespaceFailoverHistoryEntry{
+			FailoverTime:    createTimePtrOrNilIfDefault(failover.GetFailoverTime()),
+			FailoverVersion: failover.GetFailoverVersion(),
+		})
+	}
+
+	return observation
+}
@@ -0,0 +1,38 @@
+package clients
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+func TestFailoverLocalNamespace(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test050")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	observed, err := temporalService.DescribeNamespaceFailover(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if observed == nil || observed.IsGlobalNamespace || observed.ActiveClusterName != "active" {
+		t.Fatal("Expected local namespace active in cluster 'active'")
+	}
+
+	err = temporalService.FailoverNamespace(context.Background(), &core.NamespaceFailoverParameters{
+		TemporalNamespaceName: &testNamespace.Name,
+		ActiveClusterName:     "standby",
+	})
+	if err == nil {
+		t.Fatal("Expected error, because a local namespace can not be failed over")
+	}
+}
@@ -0,0 +1,123 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+type RemoteClusterService interface {
+	DescribeRemoteClusterByName(ctx context.Context, clusterName string) (*core.RemoteClusterObservation, error)
+
+	AddOrUpdateRemoteCluster(ctx context.Context, remoteCluster *core.RemoteClusterParameters) error
+	RemoveRemoteClusterByName(ctx context.Context, clusterName string) error
+
+	MapToRemoteClusterCompare(remoteCluster interface{}) (*RemoteClusterCompare, error)
+
+	Close()
+}
+
+type RemoteClusterCompare struct {
+	ClusterName      string `json:"clusterName"`
+	FrontendAddress  string `json:"frontendAddress"`
+	EnableConnection bool   `json:"enableConnection"`
+}
+
+func (s *TemporalServiceImpl) MapToRemoteClusterCompare(remoteCluster interface{}) (*RemoteClusterCompare, error) {
+	remoteClusterJson, err := json.Marshal(remoteCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var remoteClusterCompare = RemoteClusterCompare{}
+	err = json.Unmarshal(remoteClusterJson, &remoteClusterCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteClusterCompare, nil
+}
+
+// AddOrUpdateRemoteCluster adds the remote cluster or updates its address and
+// connection. The server reads the name of the remote cluster from the
+// frontend address, therefore it is verified afterwards, that the address
+// belongs to the desired cluster.
+func (s *TemporalServiceImpl) AddOrUpdateRemoteCluster(ctx context.Context, remoteCluster *core.RemoteClusterParameters) error {
+	request := &operatorservice.AddOrUpdateRemoteClusterRequest{
+		FrontendAddress:               remoteCluster.FrontendAddress,
+		EnableRemoteClusterConnection: remoteCluster.EnableConnection,
+	}
+
+	_, err := s.operatorService().AddOrUpdateRemoteCluster(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	observed, err := s.DescribeRemoteClusterByName(ctx, remoteCluster.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	if observed == nil || observed.FrontendAddress != remoteCluster.FrontendAddress {
+		return errors.New("Frontend address '" + remoteCluster.FrontendAddress + "' does not belong to cluster '" + remoteCluster.ClusterName + "'")
+	}
+
+	return nil
+}
+
+func (s *TemporalServiceImpl) DescribeRemoteClusterByName(ctx context.Context, clusterName string) (*core.RemoteClusterObservation, error) {
+	var nextPageToken []byte
+	for {
+		request := &operatorservice.ListClustersRequest{
+			NextPageToken: nextPageToken,
+		}
+
+		response, err := s.operatorService().ListClusters(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range response.GetClusters() {
+			if cluster.GetClusterName() == clusterName {
+				return &core.RemoteClusterObservation{
+					ClusterName:            cluster.GetClusterName(),
+					FrontendAddress:        cluster.GetAddress(),
+					EnableConnection:       cluster.GetIsConnectionEnabled(),
+					ClusterId:              cluster.GetClusterId(),
+					InitialFailoverVersion: cluster.GetInitialFailoverVersion(),
+					HistoryShardCount:      cluster.GetHistoryShardCount(),
+				}, nil
+			}
+		}
+
+		nextPageToken = response.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+func (s *TemporalServiceImpl) RemoveRemoteClusterByName(ctx context.Context, clusterName string) error {
+	deleterequest := &operatorservice.RemoveRemoteClusterRequest{
+		ClusterName: clusterName,
+	}
+
+	_, err := s.operatorService().RemoveRemoteCluster(ctx, deleterequest)
+
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		s.logger.Debug("Remote cluster '" + clusterName + "' not found. " + err.Error())
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
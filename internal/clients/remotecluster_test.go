@@ -0,0 +1,33 @@
+package clients
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestDescribeRemoteClusterByName(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalService(t)
+
+	// The connected cluster is listed as well. Its name is configured by
+	// the auto-setup image.
+	foundCluster, err := temporalService.DescribeRemoteClusterByName(context.Background(), "active")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if foundCluster == nil || foundCluster.FrontendAddress == "" || foundCluster.ClusterId == "" {
+		t.Fatal("Expected cluster 'active' with frontend address and cluster id")
+	}
+
+	notFoundCluster, err := temporalService.DescribeRemoteClusterByName(context.Background(), "unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if notFoundCluster != nil {
+		t.Fatal("Expected cluster 'unknown' not to be found")
+	}
+}
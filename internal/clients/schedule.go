@@ -0,0 +1,761 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enums "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+const (
+	payloadMetadataEncoding = "encoding"
+	payloadMetadataType     = "type"
+	payloadEncodingJson     = "json/plain"
+
+	maxNextActionTimes = 5
+
+	defaultPauseNote   = "Paused by provider-temporal"
+	defaultUnpauseNote = "Unpaused by provider-temporal"
+)
+
+type ScheduleService interface {
+	DescribeScheduleById(ctx context.Context, namespace string, scheduleId string) (*core.ScheduleObservation, error)
+
+	CreateSchedule(ctx context.Context, schedule *core.ScheduleParameters) error
+	UpdateSchedule(ctx context.Context, schedule *core.ScheduleParameters) error
+	PauseSchedule(ctx context.Context, schedule *core.ScheduleParameters) error
+	UnpauseSchedule(ctx context.Context, schedule *core.ScheduleParameters) error
+	TriggerScheduleById(ctx context.Context, namespace string, scheduleId string, overlapPolicy string) error
+	DeleteScheduleById(ctx context.Context, namespace string, scheduleId string) error
+
+	MapToScheduleCompare(schedule interface{}) (*ScheduleCompare, error)
+
+	Close()
+}
+
+// ScheduleCompare contains the fields of a Schedule, which are returned
+// unchanged by the server. Calendars and cron expressions are normalized by
+// the server and are therefore not part of it.
+type ScheduleCompare struct {
+	ScheduleId            string                `json:"scheduleId"`
+	TemporalNamespaceName *string               `json:"temporalNamespaceName,omitempty"`
+	Spec                  ScheduleSpecCompare   `json:"spec"`
+	Action                core.ScheduleAction   `json:"action"`
+	Policies              core.SchedulePolicies `json:"policies"`
+	State                 ScheduleStateCompare  `json:"state"`
+}
+
+// ScheduleStateCompare does not contain the remaining actions, because they
+// are decreased by the server with every action.
+type ScheduleStateCompare struct {
+	Paused         bool `json:"paused,omitempty"`
+	LimitedActions bool `json:"limitedActions,omitempty"`
+}
+
+type ScheduleSpecCompare struct {
+	Intervals    []core.IntervalSpec `json:"intervals,omitempty"`
+	StartTime    *metav1.Time        `json:"startTime,omitempty"`
+	EndTime      *metav1.Time        `json:"endTime,omitempty"`
+	Jitter       *metav1.Duration    `json:"jitter,omitempty"`
+	TimezoneName *string             `json:"timezoneName,omitempty"`
+}
+
+func (s *TemporalServiceImpl) MapToScheduleCompare(schedule interface{}) (*ScheduleCompare, error) {
+	scheduleJson, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduleCompare = ScheduleCompare{}
+	err = json.Unmarshal(scheduleJson, &scheduleCompare)
+	if err != nil {
+		return nil, err
+	}
+
+	searchAttributes := scheduleCompare.Action.StartWorkflow.SearchAttributes
+	sort.Slice(searchAttributes, func(i, j int) bool {
+		return searchAttributes[i].Name < searchAttributes[j].Name
+	})
+
+	return &scheduleCompare, nil
+}
+
+func (s *TemporalServiceImpl) CreateSchedule(ctx context.Context, schedule *core.ScheduleParameters) error {
+	err := s.validateScheduleAction(ctx, schedule)
+	if err != nil {
+		return err
+	}
+
+	createrequest := &workflowservice.CreateScheduleRequest{
+		Namespace:  *schedule.TemporalNamespaceName,
+		ScheduleId: schedule.ScheduleId,
+		Schedule:   mapToSchedule(schedule),
+		RequestId:  uuid.New().String(),
+	}
+
+	_, err = s.client.WorkflowService().CreateSchedule(ctx, createrequest)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+
+	if errors.As(err, &alreadyStarted) {
+		s.logger.Debug("Schedule '" + schedule.ScheduleId + "' already exists. " + err.Error())
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *TemporalServiceImpl) DescribeScheduleById(ctx context.Context, namespace string, scheduleId string) (*core.ScheduleObservation, error) {
+	request := &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleId,
+	}
+
+	response, err := s.client.WorkflowService().DescribeSchedule(ctx, request)
+
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		s.logger.Debug("Schedule '" + scheduleId + "' not found. " + err.Error())
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || response.Schedule == nil {
+		return nil, nil
+	}
+
+	return mapDescribeScheduleResponse(namespace, scheduleId, response), nil
+}
+
+func (s *TemporalServiceImpl) UpdateSchedule(ctx context.Context, schedule *core.ScheduleParameters) error {
+	err := s.validateScheduleAction(ctx, schedule)
+	if err != nil {
+		return err
+	}
+
+	updaterequest := &workflowservice.UpdateScheduleRequest{
+		Namespace:  *schedule.TemporalNamespaceName,
+		ScheduleId: schedule.ScheduleId,
+		Schedule:   mapToSchedule(schedule),
+		RequestId:  uuid.New().String(),
+	}
+
+	_, err = s.client.WorkflowService().UpdateSchedule(ctx, updaterequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PauseSchedule pauses the Schedule and records the note of its state.
+func (s *TemporalServiceImpl) PauseSchedule(ctx context.Context, schedule *core.ScheduleParameters) error {
+	return s.patchSchedule(ctx, *schedule.TemporalNamespaceName, schedule.ScheduleId, &schedulepb.SchedulePatch{
+		Pause: resolveNoteOrDefault(schedule.State.Note, defaultPauseNote),
+	})
+}
+
+// UnpauseSchedule unpauses the Schedule and records the note of its state.
+func (s *TemporalServiceImpl) UnpauseSchedule(ctx context.Context, schedule *core.ScheduleParameters) error {
+	return s.patchSchedule(ctx, *schedule.TemporalNamespaceName, schedule.ScheduleId, &schedulepb.SchedulePatch{
+		Unpause: resolveNoteOrDefault(schedule.State.Note, defaultUnpauseNote),
+	})
+}
+
+// TriggerScheduleById takes the action of the Schedule immediately. An empty
+// overlapPolicy uses the overlap policy of the Schedule.
+func (s *TemporalServiceImpl) TriggerScheduleById(ctx context.Context, namespace string, scheduleId string, overlapPolicy string) error {
+	policy, ok := enums.ScheduleOverlapPolicy_value[overlapPolicy]
+	if overlapPolicy != "" && !ok {
+		return errors.New("unknown overlap policy '" + overlapPolicy + "'")
+	}
+
+	return s.patchSchedule(ctx, namespace, scheduleId, &schedulepb.SchedulePatch{
+		TriggerImmediately: &schedulepb.TriggerImmediatelyRequest{
+			OverlapPolicy: enums.ScheduleOverlapPolicy(policy),
+		},
+	})
+}
+
+func (s *TemporalServiceImpl) patchSchedule(ctx context.Context, namespace string, scheduleId string, patch *schedulepb.SchedulePatch) error {
+	patchrequest := &workflowservice.PatchScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleId,
+		Patch:      patch,
+		RequestId:  uuid.New().String(),
+	}
+
+	_, err := s.client.WorkflowService().PatchSchedule(ctx, patchrequest)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *TemporalServiceImpl) DeleteScheduleById(ctx context.Context, namespace string, scheduleId string) error {
+	deleterequest := &workflowservice.DeleteScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleId,
+	}
+
+	_, err := s.client.WorkflowService().DeleteSchedule(ctx, deleterequest)
+
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		s.logger.Debug("Schedule '" + scheduleId + "' not found. " + err.Error())
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func mapToSchedule(schedule *core.ScheduleParameters) *schedulepb.Schedule {
+	return &schedulepb.Schedule{
+		Spec: mapToScheduleSpec(&schedule.Spec),
+		Action: &schedulepb.ScheduleAction{
+			Action: &schedulepb.ScheduleAction_StartWorkflow{
+				StartWorkflow: mapToNewWorkflowExecutionInfo(&schedule.Action.StartWorkflow),
+			},
+		},
+		Policies: &schedulepb.SchedulePolicies{
+			OverlapPolicy:  enums.ScheduleOverlapPolicy(enums.ScheduleOverlapPolicy_value[schedule.Policies.OverlapPolicy]),
+			CatchupWindow:  resolveDuration(schedule.Policies.CatchupWindow),
+			PauseOnFailure: schedule.Policies.PauseOnFailure,
+		},
+		State: &schedulepb.ScheduleState{
+			Paused:           schedule.State.Paused,
+			Notes:            resolvePtrOrDefault(schedule.State.Note),
+			LimitedActions:   schedule.State.LimitedActions,
+			RemainingActions: schedule.State.RemainingActions,
+		},
+	}
+}
+
+func mapToScheduleSpec(spec *core.ScheduleSpec) *schedulepb.ScheduleSpec {
+	intervals := make([]*schedulepb.IntervalSpec, 0, len(spec.Intervals))
+	for _, interval := range spec.Intervals {
+		intervals = append(intervals, &schedulepb.IntervalSpec{
+			Interval: resolveDuration(&interval.Every),
+			Phase:    resolveDuration(interval.Phase),
+		})
+	}
+
+	return &schedulepb.ScheduleSpec{
+		Calendar:                  mapToCalendarSpecs(spec.Calendars),
+		StructuredCalendar:        mapToStructuredCalendarSpecs(spec.StructuredCalendars),
+		CronString:                spec.CronExpressions,
+		Interval:                  intervals,
+		ExcludeCalendar:           mapToCalendarSpecs(spec.ExcludeCalendars),
+		ExcludeStructuredCalendar: mapToStructuredCalendarSpecs(spec.ExcludeStructuredCalendars),
+		StartTime:                 resolveTime(spec.StartTime),
+		EndTime:                   resolveTime(spec.EndTime),
+		Jitter:                    resolveDuration(spec.Jitter),
+		TimezoneName:              resolvePtrOrDefault(spec.TimezoneName),
+	}
+}
+
+func mapToCalendarSpecs(calendars []core.CalendarSpec) []*schedulepb.CalendarSpec {
+	result := make([]*schedulepb.CalendarSpec, 0, len(calendars))
+	for _, calendar := range calendars {
+		result = append(result, &schedulepb.CalendarSpec{
+			Second:     resolvePtrOrDefault(calendar.Second),
+			Minute:     resolvePtrOrDefault(calendar.Minute),
+			Hour:       resolvePtrOrDefault(calendar.Hour),
+			DayOfMonth: resolvePtrOrDefault(calendar.DayOfMonth),
+			Month:      resolvePtrOrDefault(calendar.Month),
+			Year:       resolvePtrOrDefault(calendar.Year),
+			DayOfWeek:  resolvePtrOrDefault(calendar.DayOfWeek),
+			Comment:    resolvePtrOrDefault(calendar.Comment),
+		})
+	}
+	return result
+}
+
+func mapToStructuredCalendarSpecs(calendars []core.StructuredCalendarSpec) []*schedulepb.StructuredCalendarSpec {
+	result := make([]*schedulepb.StructuredCalendarSpec, 0, len(calendars))
+	for _, calendar := range calendars {
+		result = append(result, &schedulepb.StructuredCalendarSpec{
+			Second:     mapToRanges(calendar.Second),
+			Minute:     mapToRanges(calendar.Minute),
+			Hour:       mapToRanges(calendar.Hour),
+			DayOfMonth: mapToRanges(calendar.DayOfMonth),
+			Month:      mapToRanges(calendar.Month),
+			Year:       mapToRanges(calendar.Year),
+			DayOfWeek:  mapToRanges(calendar.DayOfWeek),
+			Comment:    resolvePtrOrDefault(calendar.Comment),
+		})
+	}
+	return result
+}
+
+func mapToRanges(ranges []core.CalendarRange) []*schedulepb.Range {
+	result := make([]*schedulepb.Range, 0, len(ranges))
+	for _, r := range ranges {
+		result = append(result, &schedulepb.Range{
+			Start: r.Start,
+			End:   resolveInt32PtrOrDefault(r.End),
+			Step:  resolveInt32PtrOrDefault(r.Step),
+		})
+	}
+	return result
+}
+
+func mapToNewWorkflowExecutionInfo(action *core.ScheduleStartWorkflowAction) *workflowpb.NewWorkflowExecutionInfo {
+	return &workflowpb.NewWorkflowExecutionInfo{
+		WorkflowId:   action.WorkflowId,
+		WorkflowType: &commonpb.WorkflowType{Name: action.WorkflowType},
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: action.TaskQueue,
+			Kind: enums.TASK_QUEUE_KIND_NORMAL,
+		},
+		WorkflowExecutionTimeout: resolveDuration(action.WorkflowExecutionTimeout),
+		WorkflowRunTimeout:       resolveDuration(action.WorkflowRunTimeout),
+		WorkflowTaskTimeout:      resolveDuration(action.WorkflowTaskTimeout),
+		Input:                    mapToPayloads(action.Input),
+		Memo:                     mapToMemo(action.Memo),
+		SearchAttributes:         mapToSearchAttributes(action.SearchAttributes),
+	}
+}
+
+func mapToPayloads(input []string) *commonpb.Payloads {
+	if len(input) == 0 {
+		return nil
+	}
+
+	payloads := make([]*commonpb.Payload, 0, len(input))
+	for _, value := range input {
+		payloads = append(payloads, createJsonPayload(value))
+	}
+	return &commonpb.Payloads{Payloads: payloads}
+}
+
+func mapToMemo(memo map[string]string) *commonpb.Memo {
+	if len(memo) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]*commonpb.Payload, len(memo))
+	for key, value := range memo {
+		fields[key] = createJsonPayload(value)
+	}
+	return &commonpb.Memo{Fields: fields}
+}
+
+func mapToSearchAttributes(searchAttributes []core.ScheduleSearchAttribute) *commonpb.SearchAttributes {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]*commonpb.Payload, len(searchAttributes))
+	for _, searchAttribute := range searchAttributes {
+		payload := createJsonPayload(searchAttribute.Value)
+		payload.Metadata[payloadMetadataType] = []byte(searchAttribute.Type)
+		fields[searchAttribute.Name] = payload
+	}
+	return &commonpb.SearchAttributes{IndexedFields: fields}
+}
+
+func createJsonPayload(value string) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			payloadMetadataEncoding: []byte(payloadEncodingJson),
+		},
+		Data: []byte(value),
+	}
+}
+
+func mapDescribeScheduleResponse(namespace string, scheduleId string, response *workflowservice.DescribeScheduleResponse) *core.ScheduleObservation {
+	observation := &core.ScheduleObservation{
+		ScheduleId:            scheduleId,
+		TemporalNamespaceName: namespace,
+	}
+
+	if spec := response.Schedule.Spec; spec != nil {
+		intervals := make([]core.IntervalSpec, 0, len(spec.Interval))
+		for _, interval := range spec.Interval {
+			intervals = append(intervals, core.IntervalSpec{
+				Every: resolveDurationOrDefault(interval.Interval),
+				Phase: createDurationPtrOrNilIfDefault(interval.Phase),
+			})
+		}
+
+		observation.Spec = core.ScheduleSpec{
+			Calendars:                  mapCalendarSpecs(spec.Calendar),
+			StructuredCalendars:        mapStructuredCalendarSpecs(spec.StructuredCalendar),
+			CronExpressions:            spec.CronString,
+			Intervals:                  intervals,
+			ExcludeCalendars:           mapCalendarSpecs(spec.ExcludeCalendar),
+			ExcludeStructuredCalendars: mapStructuredCalendarSpecs(spec.ExcludeStructuredCalendar),
+			StartTime:                  createTimePtrOrNilIfDefault(spec.StartTime),
+			EndTime:                    createTimePtrOrNilIfDefault(spec.EndTime),
+			Jitter:                     createDurationPtrOrNilIfDefault(spec.Jitter),
+			TimezoneName:               createPtrOrNilIfDefault(spec.TimezoneName),
+		}
+		if len(intervals) == 0 {
+			observation.Spec.Intervals = nil
+		}
+	}
+
+	if startWorkflow := response.Schedule.GetAction().GetStartWorkflow(); startWorkflow != nil {
+		observation.Action = core.ScheduleAction{
+			StartWorkflow: core.ScheduleStartWorkflowAction{
+				WorkflowId:               startWorkflow.WorkflowId,
+				WorkflowType:             startWorkflow.GetWorkflowType().GetName(),
+				TaskQueue:                startWorkflow.GetTaskQueue().GetName(),
+				WorkflowExecutionTimeout: createDurationPtrOrNilIfDefault(startWorkflow.WorkflowExecutionTimeout),
+				WorkflowRunTimeout:       createDurationPtrOrNilIfDefault(startWorkflow.WorkflowRunTimeout),
+				WorkflowTaskTimeout:      createDurationPtrOrNilIfDefault(startWorkflow.WorkflowTaskTimeout),
+				Input:                    mapPayloads(startWorkflow.Input),
+				Memo:                     mapMemo(startWorkflow.Memo),
+				SearchAttributes:         mapSearchAttributes(startWorkflow.SearchAttributes),
+			},
+		}
+	}
+
+	if policies := response.Schedule.Policies; policies != nil {
+		observation.Policies = core.SchedulePolicies{
+			OverlapPolicy:  policies.OverlapPolicy.String(),
+			CatchupWindow:  createDurationPtrOrNilIfDefault(policies.CatchupWindow),
+			PauseOnFailure: policies.PauseOnFailure,
+		}
+	}
+
+	if state := response.Schedule.State; state != nil {
+		observation.State = core.ScheduleState{
+			Paused:           state.Paused,
+			Note:             createPtrOrNilIfDefault(state.Notes),
+			LimitedActions:   state.LimitedActions,
+			RemainingActions: state.RemainingActions,
+		}
+	}
+
+	if info := response.Info; info != nil {
+		observation.Info = mapScheduleInfo(info)
+	}
+
+	return observation
+}
+
+func mapScheduleInfo(info *schedulepb.ScheduleInfo) *core.ScheduleInfo {
+	result := &core.ScheduleInfo{
+		ActionCount:         info.ActionCount,
+		MissedCatchupWindow: info.MissedCatchupWindow,
+		OverlapSkipped:      info.OverlapSkipped,
+		CreateTime:          createTimePtrOrNilIfDefault(info.CreateTime),
+		UpdateTime:          createTimePtrOrNilIfDefault(info.UpdateTime),
+	}
+
+	for _, execution := range info.RunningWorkflows {
+		result.RunningWorkflows = append(result.RunningWorkflows, core.ScheduleWorkflowExecution{
+			WorkflowId: execution.GetWorkflowId(),
+			RunId:      execution.GetRunId(),
+		})
+	}
+
+	for _, action := range info.RecentActions {
+		actionResult := core.ScheduleActionResult{
+			ScheduleTime: createTimePtrOrNilIfDefault(action.ScheduleTime),
+			ActualTime:   createTimePtrOrNilIfDefault(action.ActualTime),
+		}
+		if execution := action.StartWorkflowResult; execution != nil {
+			actionResult.StartedWorkflow = &core.ScheduleWorkflowExecution{
+				WorkflowId: execution.GetWorkflowId(),
+				RunId:      execution.GetRunId(),
+			}
+		}
+		result.RecentActions = append(result.RecentActions, actionResult)
+	}
+
+	for _, actionTime := range info.FutureActionTimes {
+		if len(result.NextActionTimes) == maxNextActionTimes {
+			break
+		}
+		if actionTime != nil {
+			result.NextActionTimes = append(result.NextActionTimes, metav1.NewTime(*actionTime))
+		}
+	}
+
+	return result
+}
+
+func mapPayloads(payloads *commonpb.Payloads) []string {
+	if len(payloads.GetPayloads()) == 0 {
+		return nil
+	}
+
+	input := make([]string, 0, len(payloads.Payloads))
+	for _, payload := range payloads.Payloads {
+		input = append(input, string(payload.GetData()))
+	}
+	return input
+}
+
+func mapMemo(memo *commonpb.Memo) map[string]string {
+	if len(memo.GetFields()) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]string, len(memo.Fields))
+	for key, payload := range memo.Fields {
+		fields[key] = string(payload.GetData())
+	}
+	return fields
+}
+
+func mapSearchAttributes(searchAttributes *commonpb.SearchAttributes) []core.ScheduleSearchAttribute {
+	if len(searchAttributes.GetIndexedFields()) == 0 {
+		return nil
+	}
+
+	result := make([]core.ScheduleSearchAttribute, 0, len(searchAttributes.IndexedFields))
+	for name, payload := range searchAttributes.IndexedFields {
+		result = append(result, core.ScheduleSearchAttribute{
+			Name:  name,
+			Type:  string(payload.GetMetadata()[payloadMetadataType]),
+			Value: string(payload.GetData()),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// validateScheduleAction validates that input and memo are JSON documents and
+// that each SearchAttribute exists in the namespace with the same type and a
+// value matching this type.
+func (s *TemporalServiceImpl) validateScheduleAction(ctx context.Context, schedule *core.ScheduleParameters) error {
+	action := &schedule.Action.StartWorkflow
+	for i, value := range action.Input {
+		if !json.Valid([]byte(value)) {
+			return errors.New("input " + strconv.Itoa(i) + " is not a valid JSON document")
+		}
+	}
+
+	for key, value := range action.Memo {
+		if !json.Valid([]byte(value)) {
+			return errors.New("memo '" + key + "' is not a valid JSON document")
+		}
+	}
+
+	if len(action.SearchAttributes) == 0 {
+		return nil
+	}
+
+	existing, err := s.ListSearchAttributesByNamespace(ctx, *schedule.TemporalNamespaceName)
+	if err != nil {
+		return err
+	}
+
+	existingTypes := make(map[string]string, len(existing))
+	for _, searchAttribute := range existing {
+		existingTypes[searchAttribute.Name] = searchAttribute.Type
+	}
+
+	for _, searchAttribute := range action.SearchAttributes {
+		existingType, ok := existingTypes[searchAttribute.Name]
+		if !ok {
+			return errors.New("SearchAttribute '" + searchAttribute.Name + "' does not exist in namespace '" + *schedule.TemporalNamespaceName + "'")
+		}
+
+		if existingType != searchAttribute.Type {
+			return errors.New("SearchAttribute '" + searchAttribute.Name + "' has type '" + existingType + "', but '" + searchAttribute.Type + "' was specified")
+		}
+
+		if !isValidSearchAttributeValue(searchAttribute.Type, searchAttribute.Value) {
+			return errors.New("value of SearchAttribute '" + searchAttribute.Name + "' is not a valid JSON document of type '" + searchAttribute.Type + "'")
+		}
+	}
+
+	return nil
+}
+
+func isValidSearchAttributeValue(attrType string, value string) bool {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return false
+	}
+
+	switch attrType {
+	case "Text", "Keyword":
+		_, ok := decoded.(string)
+		return ok
+	case "Int":
+		number, ok := decoded.(json.Number)
+		if !ok {
+			return false
+		}
+		_, err := number.Int64()
+		return err == nil
+	case "Double":
+		_, ok := decoded.(json.Number)
+		return ok
+	case "Bool":
+		_, ok := decoded.(bool)
+		return ok
+	case "Datetime":
+		str, ok := decoded.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(time.RFC3339Nano, str)
+		return err == nil
+	case "KeywordList":
+		list, ok := decoded.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			if _, ok := item.(string); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func mapCalendarSpecs(calendars []*schedulepb.CalendarSpec) []core.CalendarSpec {
+	if len(calendars) == 0 {
+		return nil
+	}
+
+	result := make([]core.CalendarSpec, 0, len(calendars))
+	for _, calendar := range calendars {
+		result = append(result, core.CalendarSpec{
+			Second:     createPtrOrNilIfDefault(calendar.Second),
+			Minute:     createPtrOrNilIfDefault(calendar.Minute),
+			Hour:       createPtrOrNilIfDefault(calendar.Hour),
+			DayOfMonth: createPtrOrNilIfDefault(calendar.DayOfMonth),
+			Month:      createPtrOrNilIfDefault(calendar.Month),
+			Year:       createPtrOrNilIfDefault(calendar.Year),
+			DayOfWeek:  createPtrOrNilIfDefault(calendar.DayOfWeek),
+			Comment:    createPtrOrNilIfDefault(calendar.Comment),
+		})
+	}
+	return result
+}
+
+func mapStructuredCalendarSpecs(calendars []*schedulepb.StructuredCalendarSpec) []core.StructuredCalendarSpec {
+	if len(calendars) == 0 {
+		return nil
+	}
+
+	result := make([]core.StructuredCalendarSpec, 0, len(calendars))
+	for _, calendar := range calendars {
+		result = append(result, core.StructuredCalendarSpec{
+			Second:     mapRanges(calendar.Second),
+			Minute:     mapRanges(calendar.Minute),
+			Hour:       mapRanges(calendar.Hour),
+			DayOfMonth: mapRanges(calendar.DayOfMonth),
+			Month:      mapRanges(calendar.Month),
+			Year:       mapRanges(calendar.Year),
+			DayOfWeek:  mapRanges(calendar.DayOfWeek),
+			Comment:    createPtrOrNilIfDefault(calendar.Comment),
+		})
+	}
+	return result
+}
+
+func mapRanges(ranges []*schedulepb.Range) []core.CalendarRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	result := make([]core.CalendarRange, 0, len(ranges))
+	for _, r := range ranges {
+		result = append(result, core.CalendarRange{
+			Start: r.Start,
+			End:   createInt32PtrOrNilIfDefault(r.End),
+			Step:  createInt32PtrOrNilIfDefault(r.Step),
+		})
+	}
+	return result
+}
+
+func resolveDuration(duration *metav1.Duration) *time.Duration {
+	if duration == nil {
+		return nil
+	}
+	return &duration.Duration
+}
+
+func resolveDurationOrDefault(duration *time.Duration) metav1.Duration {
+	if duration == nil {
+		return metav1.Duration{}
+	}
+	return metav1.Duration{Duration: *duration}
+}
+
+func createDurationPtrOrNilIfDefault(duration *time.Duration) *metav1.Duration {
+	if duration == nil || *duration == 0 {
+		return nil
+	}
+	return &metav1.Duration{Duration: *duration}
+}
+
+func resolveTime(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
+
+func createTimePtrOrNilIfDefault(t *time.Time) *metav1.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return &metav1.Time{Time: *t}
+}
+
+func resolveInt32PtrOrDefault(ptr *int32) int32 {
+	if ptr == nil {
+		return 0
+	}
+	return *ptr
+}
+
+func createInt32PtrOrNilIfDefault(value int32) *int32 {
+	if value == 0 {
+		return nil
+	}
+	return &value
+}
+
+func resolveNoteOrDefault(note *string, defaultNote string) string {
+	if note == nil || *note == "" {
+		return defaultNote
+	}
+	return *note
+}
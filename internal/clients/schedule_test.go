@@ -0,0 +1,398 @@
+package clients
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/net/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+func createScheduleService(t *testing.T) *TemporalServiceImpl {
+	temporalService := createTemporalService(t)
+	return temporalService
+}
+
+func createScheduleParameters(namespace string, scheduleId string) *core.ScheduleParameters {
+	hour := "2"
+	phase := metav1.Duration{Duration: 15 * time.Minute}
+	jitter := metav1.Duration{Duration: 30 * time.Second}
+	timezone := "Europe/Berlin"
+	end := metav1.NewTime(time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC))
+	catchupWindow := metav1.Duration{Duration: 365 * 24 * time.Hour}
+	return &core.ScheduleParameters{
+		ScheduleId:            scheduleId,
+		TemporalNamespaceName: &namespace,
+		Spec: core.ScheduleSpec{
+			Calendars: []core.CalendarSpec{
+				{Hour: &hour},
+			},
+			CronExpressions: []string{"30 4 * * 5"},
+			Intervals: []core.IntervalSpec{
+				{Every: metav1.Duration{Duration: time.Hour}, Phase: &phase},
+			},
+			EndTime:      &end,
+			Jitter:       &jitter,
+			TimezoneName: &timezone,
+		},
+		Action: core.ScheduleAction{
+			StartWorkflow: core.ScheduleStartWorkflowAction{
+				WorkflowId:   scheduleId + "-workflow",
+				WorkflowType: "TestWorkflow",
+				TaskQueue:    "test-queue",
+			},
+		},
+		Policies: core.SchedulePolicies{
+			OverlapPolicy: "Skip",
+			CatchupWindow: &catchupWindow,
+		},
+	}
+}
+
+func TestCreateSchedule(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createScheduleService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test020")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule := createScheduleParameters(testNamespace.Name, "schedule1")
+	err = temporalService.CreateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundSchedule, err := temporalService.DescribeScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSchedulesAreEqual(t, temporalService, foundSchedule, testSchedule)
+
+	if foundSchedule.Info == nil || len(foundSchedule.Info.NextActionTimes) == 0 || len(foundSchedule.Info.NextActionTimes) > 5 {
+		t.Fatal("Expected between 1 and 5 next action times")
+	}
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScheduleNotExists(t, temporalService, testNamespace.Name, testSchedule.ScheduleId)
+}
+
+func TestUpdateSchedule(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createScheduleService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test020")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule := createScheduleParameters(testNamespace.Name, "schedule2")
+	err = temporalService.CreateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule.Spec.Intervals[0].Every = metav1.Duration{Duration: 2 * time.Hour}
+	testSchedule.Spec.Jitter = nil
+	testSchedule.Action.StartWorkflow.TaskQueue = "test-queue-2"
+	testSchedule.Policies.OverlapPolicy = "BufferOne"
+	testSchedule.Policies.CatchupWindow = &metav1.Duration{Duration: time.Hour}
+	testSchedule.Policies.PauseOnFailure = true
+
+	err = temporalService.UpdateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundSchedule, err := temporalService.DescribeScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSchedulesAreEqual(t, temporalService, foundSchedule, testSchedule)
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertScheduleNotExists(t, temporalService, testNamespace.Name, testSchedule.ScheduleId)
+}
+
+func TestPauseAndUnpauseSchedule(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createScheduleService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test020")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule := createScheduleParameters(testNamespace.Name, "schedule4")
+	err = temporalService.CreateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	note := "Paused for maintenance"
+	testSchedule.State.Paused = true
+	testSchedule.State.Note = &note
+
+	err = temporalService.PauseSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundSchedule, err := temporalService.DescribeScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSchedulesAreEqual(t, temporalService, foundSchedule, testSchedule)
+	if foundSchedule.State.Note == nil || *foundSchedule.State.Note != note {
+		t.Fatal("Expected note '" + note + "'")
+	}
+
+	testSchedule.State.Paused = false
+	testSchedule.State.Note = nil
+
+	err = temporalService.UnpauseSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundSchedule, err = temporalService.DescribeScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSchedulesAreEqual(t, temporalService, foundSchedule, testSchedule)
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTriggerSchedule(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createScheduleService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test020")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule := createScheduleParameters(testNamespace.Name, "schedule5")
+	err = temporalService.CreateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = temporalService.TriggerScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId, "AllowAll")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = temporalService.TriggerScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = temporalService.TriggerScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId, "Unknown")
+	if err == nil {
+		t.Fatal("Expected error for unknown overlap policy")
+	}
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateScheduleWithInputMemoAndSearchAttributes(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createScheduleService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test021")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = temporalService.CreateSearchAttribute(context.Background(), createSearchAttributeParameters(testNamespace.Name, "scheduleKeyword", "Keyword"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule := createScheduleParameters(testNamespace.Name, "schedule6")
+	testSchedule.Action.StartWorkflow.Input = []string{`{"key":"value"}`, `42`}
+	testSchedule.Action.StartWorkflow.Memo = map[string]string{"owner": `"team-a"`}
+	testSchedule.Action.StartWorkflow.SearchAttributes = []core.ScheduleSearchAttribute{
+		{Name: "scheduleKeyword", Type: "Keyword", Value: `"abc"`},
+	}
+
+	err = temporalService.CreateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundSchedule, err := temporalService.DescribeScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSchedulesAreEqual(t, temporalService, foundSchedule, testSchedule)
+
+	testSchedule.Action.StartWorkflow.SearchAttributes[0].Type = "Int"
+	testSchedule.Action.StartWorkflow.SearchAttributes[0].Value = "1"
+	err = temporalService.UpdateSchedule(context.Background(), testSchedule)
+	if err == nil {
+		t.Fatal("Expected error for SearchAttribute with wrong type")
+	}
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsValidSearchAttributeValue(t *testing.T) {
+	cases := []struct {
+		attrType string
+		value    string
+		valid    bool
+	}{
+		{"Keyword", `"abc"`, true},
+		{"Keyword", `1`, false},
+		{"Int", `42`, true},
+		{"Int", `4.2`, false},
+		{"Double", `4.2`, true},
+		{"Bool", `true`, true},
+		{"Datetime", `"2024-01-01T00:00:00Z"`, true},
+		{"Datetime", `"yesterday"`, false},
+		{"KeywordList", `["a", "b"]`, true},
+		{"KeywordList", `["a", 1]`, false},
+		{"Text", `not json`, false},
+	}
+
+	for _, c := range cases {
+		if isValidSearchAttributeValue(c.attrType, c.value) != c.valid {
+			t.Error("Expected value " + c.value + " of type " + c.attrType + " to be valid: " + strconv.FormatBool(c.valid))
+		}
+	}
+}
+
+func TestCreateScheduleWithLimitedActions(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createScheduleService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test020")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule := createScheduleParameters(testNamespace.Name, "schedule7")
+	testSchedule.State.LimitedActions = true
+	testSchedule.State.RemainingActions = 3
+
+	err = temporalService.CreateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foundSchedule, err := temporalService.DescribeScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSchedulesAreEqual(t, temporalService, foundSchedule, testSchedule)
+	if foundSchedule.State.RemainingActions != 3 {
+		t.Fatal("Expected 3 remaining actions, but was " + strconv.FormatInt(foundSchedule.State.RemainingActions, 10))
+	}
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeleteScheduleTwice(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createScheduleService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test020")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testSchedule := createScheduleParameters(testNamespace.Name, "schedule3")
+	err = temporalService.CreateSchedule(context.Background(), testSchedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = temporalService.DeleteScheduleById(context.Background(), testNamespace.Name, testSchedule.ScheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertSchedulesAreEqual(t *testing.T, temporalService ScheduleService, actual *core.ScheduleObservation, expected *core.ScheduleParameters) {
+	t.Helper()
+	if actual == nil {
+		t.Fatal("Schedule '" + expected.ScheduleId + "' not found")
+	}
+
+	mappedActual, err := temporalService.MapToScheduleCompare(actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mappedExpected, err := temporalService.MapToScheduleCompare(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := cmp.Diff(mappedActual, mappedExpected)
+	if diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func assertScheduleNotExists(t *testing.T, temporalService ScheduleService, namespace string, scheduleId string) {
+	t.Helper()
+	schedule, err := temporalService.DescribeScheduleById(context.Background(), namespace, scheduleId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schedule != nil {
+		t.Fatal("Expected Schedule '" + scheduleId + "' to be deleted")
+	}
+}
@@ -2,12 +2,30 @@ package clients
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
 
 	enums "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
 
 	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/clients/compare"
+)
+
+const (
+	// SearchAttributeOriginCustom is the origin of search attributes, which
+	// were added to a namespace.
+	SearchAttributeOriginCustom = "Custom"
+
+	// SearchAttributeOriginSystem is the origin of search attributes, which
+	// are predefined by Temporal.
+	SearchAttributeOriginSystem = "System"
+
+	VisibilityStoreElasticsearch = "Elasticsearch"
+	VisibilityStoreSQL           = "SQL"
 )
 
 type SearchAttributeService interface {
@@ -27,98 +45,150 @@ type SearchAttributeCompare struct {
 	TemporalNamespaceName *string `json:"temporalNamespaceName,omitempty"`
 }
 
+// searchAttributeTypes are the types of search attributes, which can be
+// specified.
+var searchAttributeTypes = []string{"Text", "Keyword", "Int", "Double", "Bool", "Datetime", "KeywordList"}
+
 func (s *TemporalServiceImpl) MapToSearchAttributeCompare(searchAttribute interface{}) (*SearchAttributeCompare, error) {
-	searchAttributeJson, err := json.Marshal(searchAttribute)
-	if err != nil {
-		return nil, err
-	}
+	return ToSearchAttributeCompare(searchAttribute)
+}
 
-	var searchAttributeCompare = SearchAttributeCompare{}
-	err = json.Unmarshal(searchAttributeJson, &searchAttributeCompare)
-	if err != nil {
-		return nil, err
+// ToSearchAttributeCompare maps the parameters or the observation of a search
+// attribute field by field and normalizes the case of its type.
+func ToSearchAttributeCompare(searchAttribute interface{}) (*SearchAttributeCompare, error) {
+	switch a := searchAttribute.(type) {
+	case *core.SearchAttributeParameters:
+		return &SearchAttributeCompare{
+			Name:                  a.Name,
+			Type:                  compare.Enum(a.Type, searchAttributeTypes...),
+			TemporalNamespaceName: compare.String(a.TemporalNamespaceName),
+		}, nil
+	case *core.SearchAttributeObservation:
+		return &SearchAttributeCompare{
+			Name:                  a.Name,
+			Type:                  compare.Enum(a.Type, searchAttributeTypes...),
+			TemporalNamespaceName: compare.String(&a.TemporalNamespaceName),
+		}, nil
+	default:
+		return nil, fmt.Errorf("cannot compare %T as search attribute", searchAttribute)
 	}
-
-	return &searchAttributeCompare, nil
 }
 
 func (s *TemporalServiceImpl) CreateSearchAttribute(ctx context.Context, searchAttribute *core.SearchAttributeParameters) error {
-
-	searchAttributeMap := make(map[string]enums.IndexedValueType)
-	searchAttributeMap[searchAttribute.Name] = enums.IndexedValueType(enums.IndexedValueType_value[searchAttribute.Type])
-
-	createrequest := &operatorservice.AddSearchAttributesRequest{
-		Namespace:        *searchAttribute.TemporalNamespaceName,
-		SearchAttributes: searchAttributeMap,
-	}
-	_, err := s.client.OperatorService().AddSearchAttributes(ctx, createrequest)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return s.AddSearchAttributes(ctx, *searchAttribute.TemporalNamespaceName, map[string]string{searchAttribute.Name: searchAttribute.Type})
 }
 
+// DescribeSearchAttributeByName returns the custom search attribute with the
+// given name or nil if it does not exist. A system search attribute with the
+// name is returned with origin System.
 func (s *TemporalServiceImpl) DescribeSearchAttributeByName(ctx context.Context, namespace string, name string) (*core.SearchAttributeObservation, error) {
-	response, err := s.ListSearchAttributesByNamespace(ctx, namespace)
-
+	response, err := s.listSearchAttributes(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	if response == nil {
-		return nil, nil
+	if attrType, ok := response.GetCustomAttributes()[name]; ok {
+		return mapSearchAttribute(response, namespace, name, attrType, SearchAttributeOriginCustom), nil
 	}
 
-	for _, customAttribute := range response {
-		if customAttribute.Name == name {
-			return customAttribute, nil
-		}
+	if attrType, ok := response.GetSystemAttributes()[name]; ok {
+		return mapSearchAttribute(response, namespace, name, attrType, SearchAttributeOriginSystem), nil
 	}
 	return nil, nil
 }
 
+// ListSearchAttributesByNamespace returns the custom search attributes of the
+// namespace.
 func (s *TemporalServiceImpl) ListSearchAttributesByNamespace(ctx context.Context, namespace string) ([]*core.SearchAttributeObservation, error) {
-	request := &operatorservice.ListSearchAttributesRequest{
-		Namespace: namespace,
-	}
-
-	response, err := s.client.OperatorService().ListSearchAttributes(ctx, request)
+	response, err := s.listSearchAttributes(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	if response == nil {
-		return make([]*core.SearchAttributeObservation, 0), nil
+	var customAttributes = make([]*core.SearchAttributeObservation, 0, len(response.GetCustomAttributes()))
+
+	for attrName, attrType := range response.GetCustomAttributes() {
+		customAttributes = append(customAttributes, mapSearchAttribute(response, namespace, attrName, attrType, SearchAttributeOriginCustom))
 	}
 
-	var customAttributes = make([]*core.SearchAttributeObservation, 0, len(response.CustomAttributes))
+	return customAttributes, nil
+}
+
+func (s *TemporalServiceImpl) listSearchAttributes(ctx context.Context, namespace string) (*operatorservice.ListSearchAttributesResponse, error) {
+	request := &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	}
 
-	for attrName, attrType := range response.CustomAttributes {
-		customAttribute := core.SearchAttributeObservation{
-			Name:                  attrName,
-			Type:                  attrType.String(),
-			TemporalNamespaceName: namespace,
-		}
+	// SearchAttributes of the same namespace share the response
+	return s.searchAttributes.get(s.searchAttributeCacheKey(namespace), func() (*operatorservice.ListSearchAttributesResponse, error) {
+		return s.operatorService().ListSearchAttributes(ctx, request)
+	})
+}
 
-		customAttributes = append(customAttributes, &customAttribute)
+func mapSearchAttribute(response *operatorservice.ListSearchAttributesResponse, namespace string, name string, attrType enums.IndexedValueType, origin string) *core.SearchAttributeObservation {
+	return &core.SearchAttributeObservation{
+		Name:                  name,
+		Type:                  attrType.String(),
+		TemporalNamespaceName: namespace,
+		Origin:                origin,
+		VisibilityStore:       visibilityStore(response),
+		StorageType:           response.GetStorageSchema()[name],
 	}
+}
 
-	return customAttributes, nil
+// visibilityStore returns Elasticsearch, if the server reports the mapping of
+// its index as storage schema, otherwise SQL, which has no storage schema.
+func visibilityStore(response *operatorservice.ListSearchAttributesResponse) string {
+	if len(response.GetStorageSchema()) > 0 {
+		return VisibilityStoreElasticsearch
+	}
+	return VisibilityStoreSQL
 }
 
 func (s *TemporalServiceImpl) DeleteSearchAttributeByName(ctx context.Context, namespace string, name string) error {
-	searchAttributeNames := []string{name}
+	return s.RemoveSearchAttributes(ctx, namespace, []string{name})
+}
+
+// IsUnsupportedError returns true, if the server rejected a request, because
+// it does not support it, e.g. a search attribute type, which its visibility
+// store does not support. Retrying such a request does not succeed.
+func IsUnsupportedError(err error) bool {
+	var unimplemented *serviceerror.Unimplemented
+	var invalidArgument *serviceerror.InvalidArgument
+	var failedPrecondition *serviceerror.FailedPrecondition
+	return errors.As(err, &unimplemented) || errors.As(err, &invalidArgument) || errors.As(err, &failedPrecondition)
+}
+
+// SearchAttributeLimit is the maximum number of custom search attributes of a
+// type per namespace, which the server enforces.
+type SearchAttributeLimit struct {
+	Limit int
+	Type  string
+}
 
-	deleterequest := &operatorservice.RemoveSearchAttributesRequest{
-		Namespace:        namespace,
-		SearchAttributes: searchAttributeNames,
+var searchAttributeLimitPattern = regexp.MustCompile(`(?i)cannot have more than (\d+) search attributes? of type (\w+)`)
+
+// ParseSearchAttributeLimit returns the limit, if the message is the error,
+// with which the server rejects a search attribute, because the limit of
+// custom search attributes of its type is reached. Retrying does not succeed
+// until another search attribute of the type is removed.
+func ParseSearchAttributeLimit(message string) (*SearchAttributeLimit, bool) {
+	match := searchAttributeLimitPattern.FindStringSubmatch(message)
+	if match == nil {
+		return nil, false
 	}
 
-	_, err := s.client.OperatorService().RemoveSearchAttributes(ctx, deleterequest)
+	limit, err := strconv.Atoi(match[1])
 	if err != nil {
-		return err
+		return nil, false
 	}
+	return &SearchAttributeLimit{Limit: limit, Type: match[2]}, true
+}
 
-	return nil
+// IsNamespaceUnavailable returns true, if the server rejected a request,
+// because the namespace does not exist or is not registered yet.
+func IsNamespaceUnavailable(err error) bool {
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	var namespaceInvalidState *serviceerror.NamespaceInvalidState
+	return errors.As(err, &namespaceNotFound) || errors.As(err, &namespaceInvalidState)
 }
@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/serviceerror"
 	"golang.org/x/net/context"
 
 	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
@@ -113,3 +116,163 @@ func assertSearchAttributeCount(t *testing.T, temporalService *TemporalServiceIm
 		t.Fatal("Expected SearchAttribute Count is " + strconv.Itoa(expectedCount) + ", but was " + strconv.Itoa(len(searchAttributes)) + "\n" + string(searchAttributesAsJson))
 	}
 }
+
+func TestCreateSearchAttributeSeparateOperatorEndpoint(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalServiceWithConfig(t, `{
+		"HostPort": "localhost:7222",
+		"Operator": {
+			"HostPort": "localhost:7222"
+		}
+	}`)
+	defer temporalService.Close()
+
+	testNamespace := createDefaultNamespaceParametersWithName("Test010")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAttr := createSearchAttributeParameters(testNamespace.Name, "test1Operator", "Keyword")
+	temporalService.CreateSearchAttribute(context.Background(), testAttr)
+
+	foundSearchAttr, err := temporalService.DescribeSearchAttributeByName(context.Background(), testNamespace.Name, testAttr.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSearchAttributesAreEqual(t, temporalService, foundSearchAttr, testAttr)
+
+	temporalService.DeleteSearchAttributeByName(context.Background(), testNamespace.Name, testAttr.Name)
+	assertSearchAttributeCount(t, temporalService, testNamespace.Name, 0)
+}
+
+func TestIsUnsupportedError(t *testing.T) {
+	if !IsUnsupportedError(serviceerror.NewUnimplemented("not implemented")) {
+		t.Fatal("Expected Unimplemented to be unsupported")
+	}
+
+	if !IsUnsupportedError(serviceerror.NewInvalidArgument("cannot have more than 3 search attributes of type Bool")) {
+		t.Fatal("Expected InvalidArgument to be unsupported")
+	}
+
+	if IsUnsupportedError(serviceerror.NewUnavailable("unavailable")) {
+		t.Fatal("Expected Unavailable not to be unsupported")
+	}
+
+	if IsUnsupportedError(nil) {
+		t.Fatal("Expected nil not to be unsupported")
+	}
+}
+
+func TestIsNamespaceUnavailable(t *testing.T) {
+	if !IsNamespaceUnavailable(serviceerror.NewNamespaceNotFound("Test")) {
+		t.Fatal("Expected NamespaceNotFound to be unavailable")
+	}
+
+	if IsNamespaceUnavailable(serviceerror.NewUnavailable("unavailable")) {
+		t.Fatal("Expected Unavailable not to be a namespace error")
+	}
+
+	if IsNamespaceUnavailable(nil) {
+		t.Fatal("Expected nil not to be unavailable")
+	}
+}
+
+func TestParseSearchAttributeLimit(t *testing.T) {
+	err := serviceerror.NewInvalidArgument("Unable to create search attributes: cannot have more than 3 search attribute of type Bool.")
+	if !IsUnsupportedError(err) {
+		t.Fatal("Expected limit error to be unsupported")
+	}
+
+	limit, ok := ParseSearchAttributeLimit(err.Error())
+	if !ok {
+		t.Fatal("Expected limit to be parsed")
+	}
+
+	if diff := cmp.Diff(&SearchAttributeLimit{Limit: 3, Type: "Bool"}, limit); diff != "" {
+		t.Fatal(diff)
+	}
+
+	if _, ok := ParseSearchAttributeLimit("KeywordList is not supported"); ok {
+		t.Fatal("Expected other errors not to be a limit")
+	}
+}
+
+func TestMapSearchAttributeReportsVisibilityStore(t *testing.T) {
+	response := &operatorservice.ListSearchAttributesResponse{
+		StorageSchema: map[string]string{"CustomerId": "keyword"},
+	}
+
+	searchAttribute := mapSearchAttribute(response, "Test010", "CustomerId", enums.INDEXED_VALUE_TYPE_KEYWORD, SearchAttributeOriginCustom)
+	expected := &core.SearchAttributeObservation{
+		Name:                  "CustomerId",
+		Type:                  "Keyword",
+		TemporalNamespaceName: "Test010",
+		Origin:                SearchAttributeOriginCustom,
+		VisibilityStore:       VisibilityStoreElasticsearch,
+		StorageType:           "keyword",
+	}
+
+	if diff := cmp.Diff(expected, searchAttribute); diff != "" {
+		t.Fatal(diff)
+	}
+
+	searchAttribute = mapSearchAttribute(&operatorservice.ListSearchAttributesResponse{}, "Test010", "WorkflowId", enums.INDEXED_VALUE_TYPE_KEYWORD, SearchAttributeOriginSystem)
+	if searchAttribute.VisibilityStore != VisibilityStoreSQL || searchAttribute.StorageType != "" {
+		t.Fatalf("expected SQL without storage type, got %v", searchAttribute)
+	}
+}
+
+func TestCompareSearchAttributeDrift(t *testing.T) {
+	namespace := "test"
+
+	cases := map[string]struct {
+		spec     *core.SearchAttributeParameters
+		observed *core.SearchAttributeObservation
+		drift    bool
+	}{
+		"Equal": {
+			spec:     &core.SearchAttributeParameters{Name: "test1", Type: "Keyword", TemporalNamespaceName: &namespace},
+			observed: &core.SearchAttributeObservation{Name: "test1", Type: "Keyword", TemporalNamespaceName: namespace, Origin: SearchAttributeOriginCustom},
+		},
+		"TypeCase": {
+			spec:     &core.SearchAttributeParameters{Name: "test1", Type: "keywordlist", TemporalNamespaceName: &namespace},
+			observed: &core.SearchAttributeObservation{Name: "test1", Type: "KeywordList", TemporalNamespaceName: namespace},
+		},
+		"TypeChanged": {
+			spec:     &core.SearchAttributeParameters{Name: "test1", Type: "Text", TemporalNamespaceName: &namespace},
+			observed: &core.SearchAttributeObservation{Name: "test1", Type: "Keyword", TemporalNamespaceName: namespace},
+			drift:    true,
+		},
+		"NameChanged": {
+			spec:     &core.SearchAttributeParameters{Name: "test2", Type: "Keyword", TemporalNamespaceName: &namespace},
+			observed: &core.SearchAttributeObservation{Name: "test1", Type: "Keyword", TemporalNamespaceName: namespace},
+			drift:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec, err := ToSearchAttributeCompare(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			observed, err := ToSearchAttributeCompare(tc.observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(spec, observed); (diff != "") != tc.drift {
+				t.Fatalf("expected drift %t, got diff %s", tc.drift, diff)
+			}
+		})
+	}
+
+	if _, err := ToSearchAttributeCompare(&core.TemporalNamespaceParameters{Name: "test"}); err == nil {
+		t.Fatal("expected error for unexpected type")
+	}
+}
@@ -0,0 +1,99 @@
+package clients
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/api/operatorservice/v1"
+	"golang.org/x/sync/singleflight"
+)
+
+// searchAttributeCacheTTL is how long the search attributes of a namespace are
+// shared. The SearchAttributes of a namespace are usually reconciled within a
+// few seconds of each poll cycle.
+const searchAttributeCacheTTL = 10 * time.Second
+
+// sharedSearchAttributeCache outlives the services, because a service is
+// closed as soon as the controller disconnects after each reconcile. Its keys
+// are the hash of the credentials and the namespace, see
+// searchAttributeCacheKey.
+var sharedSearchAttributeCache = newSearchAttributeCache(searchAttributeCacheTTL)
+
+// searchAttributeCache shares the result of ListSearchAttributes across all
+// search attributes of a namespace, so that one poll cycle issues roughly one
+// request per namespace. Concurrent lookups of a namespace issue a single
+// request.
+type searchAttributeCache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	group singleflight.Group
+
+	mutex   sync.Mutex
+	entries map[string]searchAttributeCacheEntry
+	// generations are incremented by each invalidation of a key, so
+	// that a request, which started before, does not store a stale result
+	generations map[string]uint64
+}
+
+type searchAttributeCacheEntry struct {
+	response *operatorservice.ListSearchAttributesResponse
+	expires  time.Time
+}
+
+func newSearchAttributeCache(ttl time.Duration) *searchAttributeCache {
+	return &searchAttributeCache{
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     map[string]searchAttributeCacheEntry{},
+		generations: map[string]uint64{},
+	}
+}
+
+// get returns the cached search attributes of the key or lists them, if
+// they are not cached or expired.
+func (c *searchAttributeCache) get(key string, list func() (*operatorservice.ListSearchAttributesResponse, error)) (*operatorservice.ListSearchAttributesResponse, error) {
+	if c == nil {
+		return list()
+	}
+
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	generation := c.generations[key]
+	c.mutex.Unlock()
+
+	if ok && c.now().Before(entry.expires) {
+		return entry.response, nil
+	}
+
+	result, err, _ := c.group.Do(key, func() (interface{}, error) {
+		response, err := list()
+		if err != nil {
+			return nil, err
+		}
+
+		c.mutex.Lock()
+		if c.generations[key] == generation {
+			c.entries[key] = searchAttributeCacheEntry{response: response, expires: c.now().Add(c.ttl)}
+		}
+		c.mutex.Unlock()
+		return response, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*operatorservice.ListSearchAttributesResponse), nil
+}
+
+// invalidate removes the cached search attributes of the key, after they
+// were changed.
+func (c *searchAttributeCache) invalidate(key string) {
+	if c == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	delete(c.entries, key)
+	c.generations[key]++
+	c.mutex.Unlock()
+	c.group.Forget(key)
+}
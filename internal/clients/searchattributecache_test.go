@@ -0,0 +1,79 @@
+package clients
+
+import (
+	"testing"
+	"time"
+
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+)
+
+func TestSearchAttributeCacheSharesResponse(t *testing.T) {
+	now := time.Now()
+	cache := newSearchAttributeCache(searchAttributeCacheTTL)
+	cache.now = func() time.Time { return now }
+
+	calls := 0
+	list := func() (*operatorservice.ListSearchAttributesResponse, error) {
+		calls++
+		return &operatorservice.ListSearchAttributesResponse{
+			CustomAttributes: map[string]enums.IndexedValueType{"test1": enums.INDEXED_VALUE_TYPE_KEYWORD},
+		}, nil
+	}
+
+	for i := 0; i < 50; i++ {
+		response, err := cache.get("Test010", list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := response.CustomAttributes["test1"]; !ok {
+			t.Fatalf("expected search attribute test1, got %v", response.CustomAttributes)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected 1 list call, got %d", calls)
+	}
+
+	if _, err := cache.get("Test011", list); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected a list call per namespace, got %d", calls)
+	}
+}
+
+func TestSearchAttributeCacheExpiresAndInvalidates(t *testing.T) {
+	now := time.Now()
+	cache := newSearchAttributeCache(searchAttributeCacheTTL)
+	cache.now = func() time.Time { return now }
+
+	calls := 0
+	list := func() (*operatorservice.ListSearchAttributesResponse, error) {
+		calls++
+		return &operatorservice.ListSearchAttributesResponse{}, nil
+	}
+
+	if _, err := cache.get("Test010", list); err != nil {
+		t.Fatal(err)
+	}
+
+	cache.invalidate("Test010")
+	if _, err := cache.get("Test010", list); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected a list call after invalidation, got %d", calls)
+	}
+
+	now = now.Add(searchAttributeCacheTTL)
+	if _, err := cache.get("Test010", list); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected a list call after expiry, got %d", calls)
+	}
+}
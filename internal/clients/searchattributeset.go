@@ -0,0 +1,109 @@
+package clients
+
+import (
+	"context"
+	"sort"
+
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+)
+
+type SearchAttributeSetService interface {
+	DescribeSearchAttributesByNamespace(ctx context.Context, namespace string) (map[string]string, error)
+
+	AddSearchAttributes(ctx context.Context, namespace string, searchAttributes map[string]string) error
+	RemoveSearchAttributes(ctx context.Context, namespace string, names []string) error
+
+	Close()
+}
+
+// SearchAttributeSetDiff is the difference between the SearchAttributes of a
+// set and the SearchAttributes of its namespace.
+type SearchAttributeSetDiff struct {
+	// Missing maps the name of each SearchAttribute, which must be added, to
+	// its type
+	Missing map[string]string
+	// Removed are the names of the SearchAttributes, which must be removed
+	Removed []string
+	// Conflicting are the names of the SearchAttributes, which exist with
+	// another type
+	Conflicting []string
+}
+
+// IsEmpty returns true, if the namespace contains the SearchAttributes of the
+// set
+func (d *SearchAttributeSetDiff) IsEmpty() bool {
+	return len(d.Missing) == 0 && len(d.Removed) == 0 && len(d.Conflicting) == 0
+}
+
+// DiffSearchAttributeSet compares the SearchAttributes of the spec with the
+// observed SearchAttributes of the namespace. Managed SearchAttributes, which
+// are no longer part of the spec, are removed.
+func DiffSearchAttributeSet(spec map[string]string, managed []string, observed map[string]string) *SearchAttributeSetDiff {
+	diff := &SearchAttributeSetDiff{
+		Missing: map[string]string{},
+	}
+
+	for name, attrType := range spec {
+		observedType, ok := observed[name]
+		if !ok {
+			diff.Missing[name] = attrType
+		} else if observedType != attrType {
+			diff.Conflicting = append(diff.Conflicting, name)
+		}
+	}
+
+	for _, name := range managed {
+		if _, ok := spec[name]; ok {
+			continue
+		}
+
+		if _, ok := observed[name]; ok {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+
+	sort.Strings(diff.Conflicting)
+	sort.Strings(diff.Removed)
+	return diff
+}
+
+func (s *TemporalServiceImpl) DescribeSearchAttributesByNamespace(ctx context.Context, namespace string) (map[string]string, error) {
+	response, err := s.ListSearchAttributesByNamespace(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	searchAttributes := make(map[string]string, len(response))
+	for _, customAttribute := range response {
+		searchAttributes[customAttribute.Name] = customAttribute.Type
+	}
+	return searchAttributes, nil
+}
+
+// AddSearchAttributes adds all SearchAttributes with a single request
+func (s *TemporalServiceImpl) AddSearchAttributes(ctx context.Context, namespace string, searchAttributes map[string]string) error {
+	searchAttributeMap := make(map[string]enums.IndexedValueType, len(searchAttributes))
+	for name, attrType := range searchAttributes {
+		searchAttributeMap[name] = enums.IndexedValueType(enums.IndexedValueType_value[attrType])
+	}
+
+	request := &operatorservice.AddSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: searchAttributeMap,
+	}
+	_, err := s.operatorService().AddSearchAttributes(ctx, request)
+	s.searchAttributes.invalidate(s.searchAttributeCacheKey(namespace))
+	return err
+}
+
+// RemoveSearchAttributes removes all SearchAttributes with a single request
+func (s *TemporalServiceImpl) RemoveSearchAttributes(ctx context.Context, namespace string, names []string) error {
+	request := &operatorservice.RemoveSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: names,
+	}
+	_, err := s.operatorService().RemoveSearchAttributes(ctx, request)
+	s.searchAttributes.invalidate(s.searchAttributeCacheKey(namespace))
+	return err
+}
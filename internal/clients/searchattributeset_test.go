@@ -0,0 +1,62 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/net/context"
+)
+
+func TestAddRemoveSearchAttributes(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createSearchAttributeService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test010")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	searchAttributes := map[string]string{"setAttr1": "Keyword", "setAttr2": "Int"}
+	err = temporalService.AddSearchAttributes(context.Background(), testNamespace.Name, searchAttributes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := temporalService.DescribeSearchAttributesByNamespace(context.Background(), testNamespace.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(searchAttributes, found); diff != "" {
+		t.Fatal(diff)
+	}
+
+	err = temporalService.RemoveSearchAttributes(context.Background(), testNamespace.Name, []string{"setAttr1", "setAttr2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertSearchAttributeCount(t, temporalService, testNamespace.Name, 0)
+}
+
+func TestDiffSearchAttributeSet(t *testing.T) {
+	spec := map[string]string{"Missing": "Keyword", "Existing": "Int", "Conflicting": "Bool"}
+	managed := []string{"Existing", "Removed", "AlreadyRemoved"}
+	observed := map[string]string{"Existing": "Int", "Conflicting": "Text", "Removed": "Keyword", "Unmanaged": "Keyword"}
+
+	diff := DiffSearchAttributeSet(spec, managed, observed)
+
+	expected := &SearchAttributeSetDiff{
+		Missing:     map[string]string{"Missing": "Keyword"},
+		Removed:     []string{"Removed"},
+		Conflicting: []string{"Conflicting"},
+	}
+	if d := cmp.Diff(expected, diff); d != "" {
+		t.Fatal(d)
+	}
+
+	if !DiffSearchAttributeSet(map[string]string{"Existing": "Int"}, nil, observed).IsEmpty() {
+		t.Fatal("Expected no difference")
+	}
+}
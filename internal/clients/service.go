@@ -1,10 +1,11 @@
 package clients
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
-	"os"
 
 	"github.com/pkg/errors"
 	"golang.org/x/exp/slog"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"go.temporal.io/api/operatorservice/v1"
 	"go.temporal.io/sdk/client"
 )
 
@@ -21,11 +23,31 @@ type TemporalServiceConfig struct {
 	CACertPem string `json:"caCertPem"`
 	CertPem   string `json:"certPem"`
 	KeyPem    string `json:"keyPem"`
+
+	// Operator optionally configures a separate endpoint for the OperatorService.
+	// If it is not set, the OperatorService is called on the HostPort above.
+	Operator *TemporalServiceConfig `json:"operator,omitempty"`
+}
+
+// TLSCertificates are the PEM encoded certificates and key, which are used to
+// connect to Temporal via TLS.
+type TLSCertificates struct {
+	CACertPem string
+	CertPem   string
+	KeyPem    string
 }
 
 type TemporalServiceImpl struct {
-	client client.Client
-	logger *slog.Logger
+	client          client.Client
+	operatorClient  client.Client
+	hostPort        string
+	tlsCertificates *TLSCertificates
+	logger          *slog.Logger
+
+	// credHash identifies the credentials of the service in the shared cache
+	// of search attributes
+	credHash         string
+	searchAttributes *searchAttributeCache
 }
 
 func NewTemporalService(configData []byte) (*TemporalServiceImpl, error) {
@@ -35,13 +57,88 @@ func NewTemporalService(configData []byte) (*TemporalServiceImpl, error) {
 		return nil, errors.Wrap(err, "failed to unmarshal config data")
 	}
 
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		AddSource: true,
-		Level:     slog.LevelDebug,
-	}))
+	logger := NewLogger()
 
 	logger.Debug("Starting NewTemporalService", slog.String("hostPort", conf.HostPort), slog.Bool("useTLS", conf.UseTLS))
 
+	dialOptions, err := createDialOptions(&conf, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	clientOptions := client.Options{
+		HostPort: conf.HostPort,
+		Logger:   logger,
+		ConnectionOptions: client.ConnectionOptions{
+			DialOptions: dialOptions,
+		},
+	}
+
+	logger.Debug("Dialing Temporal client", slog.String("hostPort", conf.HostPort))
+	temporalClient, err := client.Dial(clientOptions)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial Temporal client")
+	}
+
+	var operatorClient client.Client
+	if conf.Operator != nil {
+		logger.Debug("Starting separate OperatorService client", slog.String("hostPort", conf.Operator.HostPort), slog.Bool("useTLS", conf.Operator.UseTLS))
+
+		operatorDialOptions, err := createDialOptions(conf.Operator, logger)
+		if err != nil {
+			temporalClient.Close()
+			return nil, err
+		}
+
+		// The operator endpoint might not serve the WorkflowService, therefore
+		// the client must not call it eagerly like client.Dial does.
+		operatorClient, err = client.NewLazyClient(client.Options{
+			HostPort: conf.Operator.HostPort,
+			Logger:   logger,
+			ConnectionOptions: client.ConnectionOptions{
+				DialOptions: operatorDialOptions,
+			},
+		})
+		if err != nil {
+			temporalClient.Close()
+			return nil, errors.Wrap(err, "failed to create Temporal operator client")
+		}
+	}
+
+	var tlsCertificates *TLSCertificates
+	if conf.UseTLS {
+		tlsCertificates = &TLSCertificates{
+			CACertPem: conf.CACertPem,
+			CertPem:   conf.CertPem,
+			KeyPem:    conf.KeyPem,
+		}
+	}
+
+	logger.Debug("Successfully created Temporal client")
+	return &TemporalServiceImpl{
+		client:           temporalClient,
+		operatorClient:   operatorClient,
+		hostPort:         conf.HostPort,
+		tlsCertificates:  tlsCertificates,
+		logger:           logger,
+		credHash:         hash(configData),
+		searchAttributes: sharedSearchAttributeCache,
+	}, nil
+}
+
+func hash(content []byte) string {
+	sha := sha256.Sum256(content)
+	return hex.EncodeToString(sha[:])
+}
+
+// searchAttributeCacheKey scopes the cached search attributes of the
+// namespace to the credentials, so that services of different ProviderConfigs
+// do not share them.
+func (s *TemporalServiceImpl) searchAttributeCacheKey(namespace string) string {
+	return s.credHash + "/" + namespace
+}
+
+func createDialOptions(conf *TemporalServiceConfig, logger *slog.Logger) ([]grpc.DialOption, error) {
 	var dialOptions []grpc.DialOption
 	if conf.UseTLS {
 		if conf.CACertPem == "" || conf.CertPem == "" || conf.KeyPem == "" {
@@ -71,30 +168,36 @@ func NewTemporalService(configData []byte) (*TemporalServiceImpl, error) {
 		logger.Debug("Using insecure credentials")
 		dialOptions = append(dialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
+	dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(requestIdInterceptor))
+	return dialOptions, nil
+}
 
-	clientOptions := client.Options{
-		HostPort: conf.HostPort,
-		Logger:   logger,
-		ConnectionOptions: client.ConnectionOptions{
-			DialOptions: dialOptions,
-		},
+// operatorService returns the OperatorService of the separate operator
+// endpoint, if configured, otherwise of the default endpoint.
+func (s *TemporalServiceImpl) operatorService() operatorservice.OperatorServiceClient {
+	if s.operatorClient != nil {
+		return s.operatorClient.OperatorService()
 	}
+	return s.client.OperatorService()
+}
 
-	logger.Debug("Dialing Temporal client", slog.String("hostPort", conf.HostPort))
-	temporalClient, err := client.Dial(clientOptions)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to dial Temporal client")
-	}
+// HostPort returns the address of the Temporal frontend this service is
+// connected to.
+func (s *TemporalServiceImpl) HostPort() string {
+	return s.hostPort
+}
 
-	logger.Debug("Successfully created Temporal client")
-	return &TemporalServiceImpl{
-		client: temporalClient,
-		logger: logger,
-	}, nil
+// TLSCertificates returns the certificates of the connection to the Temporal
+// frontend or nil, if TLS is not used.
+func (s *TemporalServiceImpl) TLSCertificates() *TLSCertificates {
+	return s.tlsCertificates
 }
 
 func (s *TemporalServiceImpl) Close() {
 	s.client.Close()
+	if s.operatorClient != nil {
+		s.operatorClient.Close()
+	}
 }
 
 func NewSearchAttributeService(configData []byte) (SearchAttributeService, error) {
@@ -104,3 +207,31 @@ func NewSearchAttributeService(configData []byte) (SearchAttributeService, error
 func NewNamespaceService(configData []byte) (NamespaceService, error) {
 	return NewTemporalService(configData)
 }
+
+func NewSystemInfoService(configData []byte) (SystemInfoService, error) {
+	return NewTemporalService(configData)
+}
+
+func NewScheduleService(configData []byte) (ScheduleService, error) {
+	return NewTemporalService(configData)
+}
+
+func NewWorkerBuildIdCompatibilityService(configData []byte) (WorkerBuildIdCompatibilityService, error) {
+	return NewTemporalService(configData)
+}
+
+func NewTaskQueueService(configData []byte) (TaskQueueService, error) {
+	return NewTemporalService(configData)
+}
+
+func NewSearchAttributeSetService(configData []byte) (SearchAttributeSetService, error) {
+	return NewTemporalService(configData)
+}
+
+func NewRemoteClusterService(configData []byte) (RemoteClusterService, error) {
+	return NewTemporalService(configData)
+}
+
+func NewNamespaceFailoverService(configData []byte) (NamespaceFailoverService, error) {
+	return NewTemporalService(configData)
+}
@@ -35,3 +35,33 @@ func createTemporalServiceWithConfig(t *testing.T, jsonConfig string) *TemporalS
 	}
 	return service
 }
+
+func TestHostPort(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalService(t)
+	defer temporalService.Close()
+
+	if temporalService.HostPort() != "localhost:7222" {
+		t.Fatalf("HostPort is '%s', expected 'localhost:7222'", temporalService.HostPort())
+	}
+}
+
+func TestTLSCertificates(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalService(t)
+	defer temporalService.Close()
+
+	if temporalService.TLSCertificates() != nil {
+		t.Fatal("Expected no TLS certificates without TLS")
+	}
+
+	temporalServiceTLS := createTemporalServiceTLS(t)
+	defer temporalServiceTLS.Close()
+
+	certificates := temporalServiceTLS.TLSCertificates()
+	if certificates == nil || certificates.CACertPem == "" || certificates.CertPem == "" || certificates.KeyPem == "" {
+		t.Fatal("Expected TLS certificates with TLS")
+	}
+}
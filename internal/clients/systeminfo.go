@@ -0,0 +1,28 @@
+package clients
+
+import (
+	"context"
+
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+type SystemInfoService interface {
+	GetSystemInfo(ctx context.Context) (*SystemInfo, error)
+
+	Close()
+}
+
+type SystemInfo struct {
+	ServerVersion string `json:"serverVersion"`
+}
+
+func (s *TemporalServiceImpl) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
+	response, err := s.client.WorkflowService().GetSystemInfo(ctx, &workflowservice.GetSystemInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SystemInfo{
+		ServerVersion: response.ServerVersion,
+	}, nil
+}
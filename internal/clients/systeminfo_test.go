@@ -0,0 +1,21 @@
+package clients
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetSystemInfo(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalService(t)
+
+	systemInfo, err := temporalService.GetSystemInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if systemInfo.ServerVersion == "" {
+		t.Fatal("ServerVersion is empty")
+	}
+}
@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"context"
+	"strconv"
+
+	enums "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+type TaskQueueService interface {
+	DescribeTaskQueue(ctx context.Context, namespace string, taskQueue string) (*core.TaskQueueObservation, error)
+
+	Close()
+}
+
+// DescribeTaskQueue describes the workflow and the activity tasks of a task
+// queue. Task queues are created implicitly, therefore every task queue can
+// be described.
+func (s *TemporalServiceImpl) DescribeTaskQueue(ctx context.Context, namespace string, taskQueue string) (*core.TaskQueueObservation, error) {
+	workflow, err := s.describeTaskQueueType(ctx, namespace, taskQueue, enums.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := s.describeTaskQueueType(ctx, namespace, taskQueue, enums.TASK_QUEUE_TYPE_ACTIVITY)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.TaskQueueObservation{
+		TemporalNamespaceName: namespace,
+		TaskQueue:             taskQueue,
+		Workflow:              workflow,
+		Activity:              activity,
+	}, nil
+}
+
+func (s *TemporalServiceImpl) describeTaskQueueType(ctx context.Context, namespace string, taskQueue string, taskQueueType enums.TaskQueueType) (*core.TaskQueueTypeInfo, error) {
+	request := &workflowservice.DescribeTaskQueueRequest{
+		Namespace: namespace,
+		TaskQueue: &taskqueuepb.TaskQueue{
+			Name: taskQueue,
+			Kind: enums.TASK_QUEUE_KIND_NORMAL,
+		},
+		TaskQueueType:          taskQueueType,
+		IncludeTaskQueueStatus: true,
+	}
+
+	response, err := s.client.WorkflowService().DescribeTaskQueue(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapDescribeTaskQueueResponse(response), nil
+}
+
+func mapDescribeTaskQueueResponse(response *workflowservice.DescribeTaskQueueResponse) *core.TaskQueueTypeInfo {
+	info := &core.TaskQueueTypeInfo{}
+
+	for _, poller := range response.GetPollers() {
+		info.Pollers = append(info.Pollers, core.TaskQueuePoller{
+			Identity:       poller.GetIdentity(),
+			LastAccessTime: createTimePtrOrNilIfDefault(poller.GetLastAccessTime()),
+			RatePerSecond:  formatRate(poller.GetRatePerSecond()),
+			BuildId:        poller.GetWorkerVersionCapabilities().GetBuildId(),
+			UseVersioning:  poller.GetWorkerVersionCapabilities().GetUseVersioning(),
+		})
+	}
+
+	status := response.GetTaskQueueStatus()
+	if status != nil {
+		info.BacklogCountHint = status.GetBacklogCountHint()
+		info.ReadLevel = status.GetReadLevel()
+		info.AckLevel = status.GetAckLevel()
+		info.RatePerSecond = formatRate(status.GetRatePerSecond())
+	}
+
+	return info
+}
+
+// formatRate formats a rate as decimal, because floats are not allowed in
+// Kubernetes APIs.
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', -1, 64)
+}
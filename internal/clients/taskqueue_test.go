@@ -0,0 +1,88 @@
+package clients
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"golang.org/x/net/context"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+func TestDescribeTaskQueue(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test040")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	observed, err := temporalService.DescribeTaskQueue(context.Background(), testNamespace.Name, "queue1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if observed.TaskQueue != "queue1" || observed.Workflow == nil || observed.Activity == nil {
+		t.Fatal("Expected workflow and activity info of task queue 'queue1'")
+	}
+
+	if len(observed.Workflow.Pollers) != 0 || observed.Workflow.BacklogCountHint != 0 {
+		t.Fatal("Expected no pollers and no backlog of workflow tasks")
+	}
+
+	if len(observed.Activity.Pollers) != 0 || observed.Activity.BacklogCountHint != 0 {
+		t.Fatal("Expected no pollers and no backlog of activity tasks")
+	}
+}
+
+func TestMapDescribeTaskQueueResponse(t *testing.T) {
+	lastAccessTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	response := &workflowservice.DescribeTaskQueueResponse{
+		Pollers: []*taskqueuepb.PollerInfo{
+			{
+				Identity:       "worker1",
+				LastAccessTime: &lastAccessTime,
+				RatePerSecond:  100000,
+				WorkerVersionCapabilities: &commonpb.WorkerVersionCapabilities{
+					BuildId:       "1.0",
+					UseVersioning: true,
+				},
+			},
+		},
+		TaskQueueStatus: &taskqueuepb.TaskQueueStatus{
+			BacklogCountHint: 5,
+			ReadLevel:        10,
+			AckLevel:         7,
+			RatePerSecond:    0.5,
+		},
+	}
+
+	expected := &core.TaskQueueTypeInfo{
+		Pollers: []core.TaskQueuePoller{
+			{
+				Identity:       "worker1",
+				LastAccessTime: &metav1.Time{Time: lastAccessTime},
+				RatePerSecond:  "100000",
+				BuildId:        "1.0",
+				UseVersioning:  true,
+			},
+		},
+		BacklogCountHint: 5,
+		ReadLevel:        10,
+		AckLevel:         7,
+		RatePerSecond:    "0.5",
+	}
+
+	diff := cmp.Diff(expected, mapDescribeTaskQueueResponse(response))
+	if diff != "" {
+		t.Fatal(diff)
+	}
+}
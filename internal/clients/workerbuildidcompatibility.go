@@ -0,0 +1,338 @@
+package clients
+
+import (
+	"context"
+	"errors"
+
+	enums "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+const (
+	// maxBuildIdOperations limits the operations applied by a single call of
+	// ApplyWorkerBuildIdCompatibility.
+	maxBuildIdOperations = 100
+
+	// maxReachabilityBuildIds is the default of the server's dynamic config
+	// limit.reachabilityQueryBuildIds, which limits the Build IDs of a single
+	// reachability query.
+	maxReachabilityBuildIds = 5
+)
+
+type WorkerBuildIdCompatibilityService interface {
+	DescribeWorkerBuildIdCompatibility(ctx context.Context, namespace string, taskQueue string) (*core.WorkerBuildIdCompatibilityObservation, error)
+
+	ApplyWorkerBuildIdCompatibility(ctx context.Context, compatibility *core.WorkerBuildIdCompatibilityParameters) error
+
+	DescribeBuildIdReachability(ctx context.Context, namespace string, taskQueue string, buildIds []string) ([]core.BuildIdReachability, error)
+
+	IsWorkerBuildIdCompatibilityUpToDate(compatibility *core.WorkerBuildIdCompatibilityParameters, observed *core.WorkerBuildIdCompatibilityObservation) (bool, error)
+
+	Close()
+}
+
+func (s *TemporalServiceImpl) DescribeWorkerBuildIdCompatibility(ctx context.Context, namespace string, taskQueue string) (*core.WorkerBuildIdCompatibilityObservation, error) {
+	request := &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: namespace,
+		TaskQueue: taskQueue,
+	}
+
+	response, err := s.client.WorkflowService().GetWorkerBuildIdCompatibility(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	observation := &core.WorkerBuildIdCompatibilityObservation{
+		TemporalNamespaceName: namespace,
+		TaskQueue:             taskQueue,
+	}
+
+	for _, set := range response.GetMajorVersionSets() {
+		buildIds := make([]string, len(set.GetBuildIds()))
+		copy(buildIds, set.GetBuildIds())
+		observation.VersionSets = append(observation.VersionSets, core.BuildIdVersionSet{BuildIds: buildIds})
+	}
+
+	if len(observation.VersionSets) > 0 {
+		defaultSet := observation.VersionSets[len(observation.VersionSets)-1].BuildIds
+		if len(defaultSet) > 0 {
+			observation.DefaultBuildId = defaultSet[len(defaultSet)-1]
+		}
+	}
+
+	return observation, nil
+}
+
+// ApplyWorkerBuildIdCompatibility adds, merges and promotes Build IDs until
+// the sets of the task queue match the desired sets. The operations are
+// determined one at a time from the current sets on the server.
+func (s *TemporalServiceImpl) ApplyWorkerBuildIdCompatibility(ctx context.Context, compatibility *core.WorkerBuildIdCompatibilityParameters) error {
+	for i := 0; i < maxBuildIdOperations; i++ {
+		observed, err := s.DescribeWorkerBuildIdCompatibility(ctx, *compatibility.TemporalNamespaceName, compatibility.TaskQueue)
+		if err != nil {
+			return err
+		}
+
+		request, err := nextBuildIdOperation(toBuildIdSets(compatibility.VersionSets), toBuildIdSets(observed.VersionSets))
+		if err != nil {
+			return err
+		}
+
+		if request == nil {
+			return nil
+		}
+
+		request.Namespace = *compatibility.TemporalNamespaceName
+		request.TaskQueue = compatibility.TaskQueue
+
+		s.logger.Debug("Update Build ID compatibility of task queue '" + compatibility.TaskQueue + "': " + request.String())
+		_, err = s.client.WorkflowService().UpdateWorkerBuildIdCompatibility(ctx, request)
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.New("Build ID compatibility of task queue '" + compatibility.TaskQueue + "' not applied after maximum number of operations")
+}
+
+func (s *TemporalServiceImpl) IsWorkerBuildIdCompatibilityUpToDate(compatibility *core.WorkerBuildIdCompatibilityParameters, observed *core.WorkerBuildIdCompatibilityObservation) (bool, error) {
+	request, err := nextBuildIdOperation(toBuildIdSets(compatibility.VersionSets), toBuildIdSets(observed.VersionSets))
+	if err != nil {
+		return false, err
+	}
+	return request == nil, nil
+}
+
+// DescribeBuildIdReachability returns the reachability of the Build IDs of a
+// task queue. The Build IDs are queried in batches, which the server accepts.
+func (s *TemporalServiceImpl) DescribeBuildIdReachability(ctx context.Context, namespace string, taskQueue string, buildIds []string) ([]core.BuildIdReachability, error) {
+	reachability := make([]core.BuildIdReachability, 0, len(buildIds))
+	for start := 0; start < len(buildIds); start += maxReachabilityBuildIds {
+		end := start + maxReachabilityBuildIds
+		if end > len(buildIds) {
+			end = len(buildIds)
+		}
+
+		request := &workflowservice.GetWorkerTaskReachabilityRequest{
+			Namespace:    namespace,
+			BuildIds:     buildIds[start:end],
+			TaskQueues:   []string{taskQueue},
+			Reachability: enums.TASK_REACHABILITY_CLOSED_WORKFLOWS,
+		}
+
+		response, err := s.client.WorkflowService().GetWorkerTaskReachability(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, buildIdReachability := range response.GetBuildIdReachability() {
+			reachability = append(reachability, mapBuildIdReachability(buildIdReachability, taskQueue))
+		}
+	}
+	return reachability, nil
+}
+
+func mapBuildIdReachability(buildIdReachability *taskqueuepb.BuildIdReachability, taskQueue string) core.BuildIdReachability {
+	result := core.BuildIdReachability{
+		BuildId:      buildIdReachability.GetBuildId(),
+		Reachability: "Unreachable",
+	}
+
+	for _, taskQueueReachability := range buildIdReachability.GetTaskQueueReachability() {
+		if taskQueueReachability.GetTaskQueue() != taskQueue {
+			continue
+		}
+
+		for _, taskReachability := range taskQueueReachability.GetReachability() {
+			result.TaskReachability = append(result.TaskReachability, taskReachability.String())
+
+			switch taskReachability {
+			case enums.TASK_REACHABILITY_NEW_WORKFLOWS, enums.TASK_REACHABILITY_EXISTING_WORKFLOWS, enums.TASK_REACHABILITY_OPEN_WORKFLOWS:
+				result.Reachability = "Reachable"
+			case enums.TASK_REACHABILITY_CLOSED_WORKFLOWS:
+				if result.Reachability == "Unreachable" {
+					result.Reachability = "ClosedOnly"
+				}
+			}
+		}
+	}
+	return result
+}
+
+func toBuildIdSets(versionSets []core.BuildIdVersionSet) [][]string {
+	sets := make([][]string, 0, len(versionSets))
+	for _, set := range versionSets {
+		sets = append(sets, set.BuildIds)
+	}
+	return sets
+}
+
+// nextBuildIdOperation returns the next operation, which brings the observed
+// sets closer to the desired sets, or nil if they already match. Build IDs,
+// which are observed but not desired, are ignored.
+func nextBuildIdOperation(desired [][]string, observed [][]string) (*workflowservice.UpdateWorkerBuildIdCompatibilityRequest, error) {
+	desiredSetOf := map[string]int{}
+	for i, set := range desired {
+		for _, buildId := range set {
+			if _, ok := desiredSetOf[buildId]; ok {
+				return nil, errors.New("Build ID '" + buildId + "' is specified more than once")
+			}
+			desiredSetOf[buildId] = i
+		}
+	}
+
+	observedSetOf := map[string]int{}
+	for i, set := range observed {
+		for _, buildId := range set {
+			observedSetOf[buildId] = i
+		}
+	}
+
+	// Add missing Build IDs and merge sets
+	for _, set := range desired {
+		var existing []string
+		for _, buildId := range set {
+			if _, ok := observedSetOf[buildId]; ok {
+				existing = append(existing, buildId)
+			}
+		}
+
+		if len(existing) == 0 {
+			return &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+					AddNewBuildIdInNewDefaultSet: set[0],
+				},
+			}, nil
+		}
+
+		primary := existing[len(existing)-1]
+		for _, buildId := range existing {
+			if observedSetOf[buildId] != observedSetOf[primary] {
+				return &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+					Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_MergeSets_{
+						MergeSets: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_MergeSets{
+							PrimarySetBuildId:   primary,
+							SecondarySetBuildId: buildId,
+						},
+					},
+				}, nil
+			}
+		}
+
+		for _, buildId := range set {
+			if _, ok := observedSetOf[buildId]; !ok {
+				return &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+					Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
+						AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
+							NewBuildId:                buildId,
+							ExistingCompatibleBuildId: primary,
+						},
+					},
+				}, nil
+			}
+		}
+	}
+
+	// Every observed set contains Build IDs of at most one desired set
+	for _, set := range observed {
+		desiredSet := -1
+		for _, buildId := range set {
+			i, ok := desiredSetOf[buildId]
+			if !ok {
+				continue
+			}
+			if desiredSet >= 0 && desiredSet != i {
+				return nil, errors.New("Build ID '" + buildId + "' is compatible with Build IDs of another set and sets can not be split")
+			}
+			desiredSet = i
+		}
+	}
+
+	// Order Build IDs within sets
+	for _, set := range desired {
+		var observedOrder []string
+		for _, buildId := range observed[observedSetOf[set[0]]] {
+			if _, ok := desiredSetOf[buildId]; ok {
+				observedOrder = append(observedOrder, buildId)
+			}
+		}
+
+		if next := nextPromotion(set, observedOrder); next >= 0 {
+			return &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_PromoteBuildIdWithinSet{
+					PromoteBuildIdWithinSet: set[next],
+				},
+			}, nil
+		}
+	}
+
+	// Order sets
+	desiredOrder := make([]int, 0, len(desired))
+	for i := range desired {
+		desiredOrder = append(desiredOrder, i)
+	}
+
+	var observedOrder []int
+	for _, set := range observed {
+		for _, buildId := range set {
+			if i, ok := desiredSetOf[buildId]; ok {
+				observedOrder = append(observedOrder, i)
+				break
+			}
+		}
+	}
+
+	if next := nextPromotion(desiredOrder, observedOrder); next >= 0 {
+		return &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+			Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_PromoteSetByBuildId{
+				PromoteSetByBuildId: desired[next][0],
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// nextPromotion returns the index of the next desired element, which has to
+// be moved to the end of observed, to reach the desired order. It returns -1,
+// if observed already has the desired order. Both contain the same elements.
+func nextPromotion[T comparable](desired []T, observed []T) int {
+	// Longest prefix of desired, which is a subsequence of observed
+	prefix := 0
+	for _, element := range observed {
+		if prefix < len(desired) && element == desired[prefix] {
+			prefix++
+		}
+	}
+
+	if prefix == len(desired) {
+		return -1
+	}
+
+	// Longest part after the prefix, which was already moved to the end
+	for moved := len(desired) - prefix; moved > 0; moved-- {
+		if moved <= len(observed) && equalSlices(observed[len(observed)-moved:], desired[prefix:prefix+moved]) {
+			if prefix+moved == len(desired) {
+				return -1
+			}
+			return prefix + moved
+		}
+	}
+
+	return prefix
+}
+
+func equalSlices[T comparable](a []T, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,208 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	enums "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"golang.org/x/net/context"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+func createWorkerBuildIdCompatibilityParameters(namespace string, taskQueue string, versionSets ...[]string) *core.WorkerBuildIdCompatibilityParameters {
+	sets := make([]core.BuildIdVersionSet, 0, len(versionSets))
+	for _, set := range versionSets {
+		sets = append(sets, core.BuildIdVersionSet{BuildIds: set})
+	}
+
+	return &core.WorkerBuildIdCompatibilityParameters{
+		TemporalNamespaceName: &namespace,
+		TaskQueue:             taskQueue,
+		VersionSets:           sets,
+	}
+}
+
+func TestApplyWorkerBuildIdCompatibility(t *testing.T) {
+	skipIfIsShort(t)
+
+	temporalService := createTemporalService(t)
+	testNamespace := createDefaultNamespaceParametersWithName("Test030")
+
+	err := temporalService.CreateNamespace(context.Background(), testNamespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCompatibility := createWorkerBuildIdCompatibilityParameters(testNamespace.Name, "queue1", []string{"1.0", "1.1"}, []string{"2.0"})
+	applyAndAssertWorkerBuildIdCompatibility(t, temporalService, testCompatibility)
+
+	// Add Build IDs, reorder within a set and promote the first set
+	testCompatibility = createWorkerBuildIdCompatibilityParameters(testNamespace.Name, "queue1", []string{"2.0", "2.1"}, []string{"1.1", "1.0", "1.2"})
+	applyAndAssertWorkerBuildIdCompatibility(t, temporalService, testCompatibility)
+
+	// Merge sets
+	testCompatibility = createWorkerBuildIdCompatibilityParameters(testNamespace.Name, "queue1", []string{"1.1", "1.0", "1.2", "2.0", "2.1"})
+	applyAndAssertWorkerBuildIdCompatibility(t, temporalService, testCompatibility)
+}
+
+func applyAndAssertWorkerBuildIdCompatibility(t *testing.T, temporalService *TemporalServiceImpl, expected *core.WorkerBuildIdCompatibilityParameters) {
+	t.Helper()
+	err := temporalService.ApplyWorkerBuildIdCompatibility(context.Background(), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	observed, err := temporalService.DescribeWorkerBuildIdCompatibility(context.Background(), *expected.TemporalNamespaceName, expected.TaskQueue)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	upToDate, err := temporalService.IsWorkerBuildIdCompatibilityUpToDate(expected, observed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !upToDate {
+		t.Fatal(cmp.Diff(expected.VersionSets, observed.VersionSets))
+	}
+}
+
+func TestNextBuildIdOperation(t *testing.T) {
+	cases := []struct {
+		name     string
+		desired  [][]string
+		observed [][]string
+	}{
+		{"empty", [][]string{{"a", "b"}, {"c"}}, nil},
+		{"reorder within set", [][]string{{"c", "a", "b"}}, [][]string{{"a", "b", "c"}}},
+		{"reorder sets", [][]string{{"c"}, {"b"}, {"a"}}, [][]string{{"a"}, {"b"}, {"c"}}},
+		{"merge sets", [][]string{{"a", "b", "c"}}, [][]string{{"a"}, {"b"}, {"c"}}},
+		{"ignore unknown", [][]string{{"b"}, {"a"}}, [][]string{{"a", "x"}, {"y"}, {"b"}}},
+	}
+
+	for _, c := range cases {
+		observed := c.observed
+		for i := 0; ; i++ {
+			if i > maxBuildIdOperations {
+				t.Fatal(c.name + ": too many operations")
+			}
+
+			request, err := nextBuildIdOperation(c.desired, observed)
+			if err != nil {
+				t.Fatal(c.name + ": " + err.Error())
+			}
+			if request == nil {
+				break
+			}
+			observed = simulateBuildIdOperation(observed, request)
+		}
+
+		var filtered [][]string
+		known := map[string]bool{}
+		for _, set := range c.desired {
+			for _, buildId := range set {
+				known[buildId] = true
+			}
+		}
+		for _, set := range observed {
+			var filteredSet []string
+			for _, buildId := range set {
+				if known[buildId] {
+					filteredSet = append(filteredSet, buildId)
+				}
+			}
+			if len(filteredSet) > 0 {
+				filtered = append(filtered, filteredSet)
+			}
+		}
+
+		if diff := cmp.Diff(c.desired, filtered); diff != "" {
+			t.Error(c.name + ": " + diff)
+		}
+	}
+
+	_, err := nextBuildIdOperation([][]string{{"a"}, {"b"}}, [][]string{{"a", "b"}})
+	if err == nil {
+		t.Error("Expected error, because sets can not be split")
+	}
+}
+
+// simulateBuildIdOperation applies the operation like the server does.
+func simulateBuildIdOperation(sets [][]string, request *workflowservice.UpdateWorkerBuildIdCompatibilityRequest) [][]string {
+	setOf := func(buildId string) int {
+		for i, set := range sets {
+			for _, id := range set {
+				if id == buildId {
+					return i
+				}
+			}
+		}
+		return -1
+	}
+	moveToEnd := func(set []string, buildId string) []string {
+		var result []string
+		for _, id := range set {
+			if id != buildId {
+				result = append(result, id)
+			}
+		}
+		return append(result, buildId)
+	}
+	removeSet := func(i int) [][]string {
+		result := append([][]string{}, sets[:i]...)
+		return append(result, sets[i+1:]...)
+	}
+
+	switch operation := request.Operation.(type) {
+	case *workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet:
+		return append(sets, []string{operation.AddNewBuildIdInNewDefaultSet})
+	case *workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId:
+		i := setOf(operation.AddNewCompatibleBuildId.ExistingCompatibleBuildId)
+		sets[i] = append(sets[i], operation.AddNewCompatibleBuildId.NewBuildId)
+	case *workflowservice.UpdateWorkerBuildIdCompatibilityRequest_PromoteBuildIdWithinSet:
+		i := setOf(operation.PromoteBuildIdWithinSet)
+		sets[i] = moveToEnd(sets[i], operation.PromoteBuildIdWithinSet)
+	case *workflowservice.UpdateWorkerBuildIdCompatibilityRequest_PromoteSetByBuildId:
+		i := setOf(operation.PromoteSetByBuildId)
+		set := sets[i]
+		sets = append(removeSet(i), set)
+	case *workflowservice.UpdateWorkerBuildIdCompatibilityRequest_MergeSets_:
+		primary := setOf(operation.MergeSets.PrimarySetBuildId)
+		secondary := setOf(operation.MergeSets.SecondarySetBuildId)
+		merged := append(append([]string{}, sets[secondary]...), sets[primary]...)
+		sets[primary] = merged
+		sets = removeSet(secondary)
+	}
+	return sets
+}
+
+func TestMapBuildIdReachability(t *testing.T) {
+	tests := []struct {
+		reachability []enums.TaskReachability
+		expected     string
+	}{
+		{nil, "Unreachable"},
+		{[]enums.TaskReachability{enums.TASK_REACHABILITY_CLOSED_WORKFLOWS}, "ClosedOnly"},
+		{[]enums.TaskReachability{enums.TASK_REACHABILITY_OPEN_WORKFLOWS, enums.TASK_REACHABILITY_CLOSED_WORKFLOWS}, "Reachable"},
+		{[]enums.TaskReachability{enums.TASK_REACHABILITY_CLOSED_WORKFLOWS, enums.TASK_REACHABILITY_EXISTING_WORKFLOWS}, "Reachable"},
+		{[]enums.TaskReachability{enums.TASK_REACHABILITY_NEW_WORKFLOWS}, "Reachable"},
+	}
+
+	for _, test := range tests {
+		buildIdReachability := &taskqueuepb.BuildIdReachability{
+			BuildId: "1.0",
+			TaskQueueReachability: []*taskqueuepb.TaskQueueReachability{
+				{TaskQueue: "other", Reachability: []enums.TaskReachability{enums.TASK_REACHABILITY_NEW_WORKFLOWS}},
+				{TaskQueue: "queue1", Reachability: test.reachability},
+			},
+		}
+
+		actual := mapBuildIdReachability(buildIdReachability, "queue1")
+		if actual.BuildId != "1.0" || actual.Reachability != test.expected || len(actual.TaskReachability) != len(test.reachability) {
+			t.Fatalf("Expected reachability %s for %v, but was %+v", test.expected, test.reachability, actual)
+		}
+	}
+}
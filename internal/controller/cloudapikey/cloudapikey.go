@@ -0,0 +1,428 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudapikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/syncmap"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/asyncoperation"
+	"github.com/denniskniep/provider-temporal/internal/backoff"
+	temporalcloud "github.com/denniskniep/provider-temporal/internal/clients/cloud"
+	"github.com/denniskniep/provider-temporal/internal/drift"
+	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/pollinterval"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+	"github.com/denniskniep/provider-temporal/internal/requestinfo"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	"github.com/denniskniep/provider-temporal/internal/usage"
+)
+
+const (
+	errNotCloudApiKey = "managed resource is not a CloudApiKey custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+	errGetCreds       = "cannot get credentials"
+	errDescribe       = "failed to describe CloudApiKey resource"
+	errNewClient      = "cannot create new Service"
+	errMapping        = "failed to map CloudApiKey resource as comparable"
+	errCreate         = "failed to create CloudApiKey resource"
+	errUpdate         = "failed to update CloudApiKey resource"
+	errDelete         = "failed to delete CloudApiKey resource"
+	errAsyncOperation = "failed to observe asynchronous operation of CloudApiKey resource"
+
+	// Key of the published connection detail.
+	connectionDetailApiKey = "apiKey"
+
+	stateActive   = "active"
+	stateDeleting = "deleting"
+)
+
+// Setup adds a controller that reconciles CloudApiKey managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	o.Logger.Info("Setup Controller: CloudApiKey")
+	name := managed.ControllerName(v1alpha1.CloudApiKeyGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	exhausted := backoff.NewTracker()
+	terminated := terminal.NewTracker()
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnectDisconnecter(exhausted.NewConnector(terminated.NewConnector(timeout.NewConnector(requestinfo.NewConnector(drift.NewConnector(recorder, &connector{
+			externalClientsByCreds: syncmap.Map{},
+			kube:                   mgr.GetClient(),
+			usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:           temporalcloud.NewApiKeyService,
+			logger:                 o.Logger.WithValues("controller", name)})))))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		pollinterval.WithHook(),
+		timeout.WithSyncTimeout(),
+		managed.WithRecorder(recorder),
+		// The external name is the key id, which is assigned by Temporal
+		// Cloud. Therefore it must not default to the name of the managed resource.
+		managed.WithInitializers(),
+		managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient())),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.CloudApiKeyGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ForControllerRuntime(o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.CloudApiKey{}).
+		Complete(ratelimiter.NewReconciler(name, exhausted.NewReconciler(terminated.NewReconciler(r, o.PollInterval)), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube                   client.Client
+	usage                  resource.Tracker
+	logger                 logging.Logger
+	externalClientsByCreds syncmap.Map
+	newServiceFn           func(creds []byte) (temporalcloud.ApiKeyService, error)
+}
+
+func hash(content []byte) string {
+	h := sha256.New()
+	h.Write(content)
+	sha := h.Sum(nil)
+	shaStr := hex.EncodeToString(sha)
+	return shaStr
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	logger := c.logger.WithValues("method", "connect")
+	logger.Debug("Start Connect")
+	cr, ok := mg.(*v1alpha1.CloudApiKey)
+	if !ok {
+		return nil, errors.New(errNotCloudApiKey)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	creds, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	credHash := hash(creds)
+
+	svc, err := c.newServiceFn(creds)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	ext := &external{service: svc, logger: c.logger, id: uuid.New().String()}
+	value, ok := c.externalClientsByCreds.LoadOrStore(credHash, ext)
+	if ok {
+		ext.service.Close()
+		ext = value.(*external)
+		logger.Debug("Use existing " + ext.id)
+	} else {
+		logger.Debug("Connected " + ext.id)
+	}
+
+	ext.IncrementUsageCounter()
+	return ext, nil
+}
+
+func (c *connector) Disconnect(ctx context.Context) error {
+	logger := c.logger.WithValues("method", "disconnect")
+	logger.Debug("Start Disconnect")
+
+	c.externalClientsByCreds.Range(func(key, value interface{}) bool {
+
+		ext := value.(*external)
+		ext.DecrementUsageCounter()
+		if ext.GetUsageCounter() < 0 {
+			ext.SetUsageCounter(0)
+		}
+
+		if ext.GetUsageCounter() == 0 && ext.service != nil {
+			ext.service.Close()
+			c.externalClientsByCreds.LoadAndDelete(key)
+			logger.Debug("Disconnected " + ext.id)
+		} else {
+			logger.Debug("Keep connection " + ext.id)
+		}
+
+		// this will continue iterating
+		return true
+	})
+
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service      temporalcloud.ApiKeyService
+	logger       logging.Logger
+	id           string
+	usageCounter int
+	sync.RWMutex
+}
+
+func (c *external) GetUsageCounter() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.usageCounter
+}
+
+func (c *external) IncrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter++
+}
+
+func (c *external) DecrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter--
+}
+
+func (c *external) SetUsageCounter(usageCounter int) {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter = usageCounter
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	logger := c.logger.WithValues("method", "observe", "serviceId", c.id)
+	logger.Debug("Start observe")
+	cr, ok := mg.(*v1alpha1.CloudApiKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudApiKey)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	c.logger.Debug("ExternalName: '" + externalName + "'")
+
+	if externalName == "" {
+		c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	observed, err := c.service.DescribeApiKey(ctx, externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	if observed == nil {
+		c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	c.logger.Debug("Found '" + observed.DisplayName + "' in state '" + observed.State + "'")
+
+	// Update Status
+	cr.Status.AtProvider = *observed
+
+	// Wait for the asynchronous operation of the last update
+	pending, err := temporalcloud.ObserveAsyncOperation(ctx, c.service, &cr.Status.AsyncOperation)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errAsyncOperation)
+	}
+
+	if pending {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("Update of CloudApiKey is " + cr.Status.AsyncOperation.State))
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// Temporal Cloud rejects changes while an operation is in progress,
+	// therefore the API key is reported as up to date until it is active.
+	if observed.State != stateActive {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("CloudApiKey is " + observed.State))
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	if observed.ExpiryTime != nil && observed.ExpiryTime.Time.Before(time.Now()) {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("CloudApiKey is expired"))
+	} else {
+		cr.SetConditions(xpv1.Available().WithMessage("CloudApiKey is active"))
+	}
+
+	observedCompareable, err := c.service.MapToApiKeyCompare(observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
+	}
+
+	specCompareable, err := c.service.MapToApiKeyCompare(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
+	}
+
+	diff := ""
+	resourceUpToDate := cmp.Equal(specCompareable, observedCompareable)
+
+	// Compare Spec with observed
+	if !resourceUpToDate {
+		diff = cmp.Diff(specCompareable, observedCompareable)
+	}
+	c.logger.Debug("Managed resource '" + cr.Name + "' upToDate: " + strconv.FormatBool(resourceUpToDate) + "")
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        resourceUpToDate,
+		Diff:                    diff,
+		ResourceLateInitialized: false,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	logger := c.logger.WithValues("method", "create", "serviceId", c.id)
+	logger.Debug("Start create")
+	cr, ok := mg.(*v1alpha1.CloudApiKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudApiKey)
+	}
+
+	keyId, token, err := c.service.CreateApiKey(ctx, &cr.Spec.ForProvider)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, keyId)
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' created")
+
+	// The token is only returned on creation, therefore it is published once.
+	// Later observations do not overwrite the published connection detail.
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			connectionDetailApiKey: []byte(token),
+		},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	logger := c.logger.WithValues("method", "update", "serviceId", c.id)
+	logger.Debug("Start update")
+	cr, ok := mg.(*v1alpha1.CloudApiKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudApiKey)
+	}
+
+	asyncOperationId, err := c.service.UpdateApiKey(ctx, meta.GetExternalName(cr), &cr.Spec.ForProvider)
+
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	asyncoperation.Track(&cr.Status.AsyncOperation, asyncOperationId)
+
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' updated")
+
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	logger := c.logger.WithValues("method", "delete", "serviceId", c.id)
+	logger.Debug("Start delete")
+	cr, ok := mg.(*v1alpha1.CloudApiKey)
+	if !ok {
+		return errors.New(errNotCloudApiKey)
+	}
+
+	if cr.Status.AtProvider.State == stateDeleting {
+		c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' is already being deleted")
+		return nil
+	}
+
+	err := c.service.DeleteApiKey(ctx, meta.GetExternalName(cr), cr.Status.AtProvider.ResourceVersion)
+
+	if err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' deleted")
+	return nil
+}
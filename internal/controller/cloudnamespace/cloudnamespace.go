@@ -0,0 +1,548 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudnamespace
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/syncmap"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/asyncoperation"
+	"github.com/denniskniep/provider-temporal/internal/backoff"
+	temporalcloud "github.com/denniskniep/provider-temporal/internal/clients/cloud"
+	"github.com/denniskniep/provider-temporal/internal/drift"
+	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/pollinterval"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+	"github.com/denniskniep/provider-temporal/internal/requestinfo"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	"github.com/denniskniep/provider-temporal/internal/usage"
+)
+
+const (
+	errNotCloudNamespace = "managed resource is not a CloudNamespace custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errGetPC             = "cannot get ProviderConfig"
+	errGetCreds          = "cannot get credentials"
+	errDescribe          = "failed to describe CloudNamespace resource"
+	errNewClient         = "cannot create new Service"
+	errMapping           = "failed to map CloudNamespace resource as comparable"
+	errCreate            = "failed to create CloudNamespace resource"
+	errUpdate            = "failed to update CloudNamespace resource"
+	errDelete            = "failed to delete CloudNamespace resource"
+	errAsyncOperation    = "failed to observe asynchronous operation of CloudNamespace resource"
+	errGetClientCa       = "cannot get accepted client CA bundle of CloudNamespace resource"
+	errEmptyClientCa     = "accepted client CA bundle of CloudNamespace resource is empty"
+
+	// Keys of the published connection details.
+	connectionDetailHostPort  = "hostPort"
+	connectionDetailNamespace = "namespace"
+
+	stateActive   = "active"
+	stateDeleting = "deleting"
+)
+
+// Setup adds a controller that reconciles CloudNamespace managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	o.Logger.Info("Setup Controller: CloudNamespace")
+	name := managed.ControllerName(v1alpha1.CloudNamespaceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	exhausted := backoff.NewTracker()
+	terminated := terminal.NewTracker()
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnectDisconnecter(exhausted.NewConnector(terminated.NewConnector(timeout.NewConnector(requestinfo.NewConnector(drift.NewConnector(recorder, &connector{
+			externalClientsByCreds: syncmap.Map{},
+			kube:                   mgr.GetClient(),
+			usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:           temporalcloud.NewNamespaceService,
+			logger:                 o.Logger.WithValues("controller", name)})))))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		pollinterval.WithHook(),
+		timeout.WithSyncTimeout(),
+		managed.WithRecorder(recorder),
+		// The external name is the namespace id, which is assigned by Temporal
+		// Cloud. Therefore it must not default to the name of the managed resource.
+		managed.WithInitializers(),
+		managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient())),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.CloudNamespaceGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ForControllerRuntime(o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.CloudNamespace{}).
+		Complete(ratelimiter.NewReconciler(name, exhausted.NewReconciler(terminated.NewReconciler(r, o.PollInterval)), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube                   client.Client
+	usage                  resource.Tracker
+	logger                 logging.Logger
+	externalClientsByCreds syncmap.Map
+	newServiceFn           func(creds []byte) (temporalcloud.NamespaceService, error)
+}
+
+func hash(content []byte) string {
+	h := sha256.New()
+	h.Write(content)
+	sha := h.Sum(nil)
+	shaStr := hex.EncodeToString(sha)
+	return shaStr
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	logger := c.logger.WithValues("method", "connect")
+	logger.Debug("Start Connect")
+	cr, ok := mg.(*v1alpha1.CloudNamespace)
+	if !ok {
+		return nil, errors.New(errNotCloudNamespace)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	creds, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	credHash := hash(creds)
+
+	svc, err := c.newServiceFn(creds)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	ext := &external{service: svc, kube: c.kube, logger: c.logger, id: uuid.New().String()}
+	value, ok := c.externalClientsByCreds.LoadOrStore(credHash, ext)
+	if ok {
+		ext.service.Close()
+		ext = value.(*external)
+		logger.Debug("Use existing " + ext.id)
+	} else {
+		logger.Debug("Connected " + ext.id)
+	}
+
+	ext.IncrementUsageCounter()
+	return ext, nil
+}
+
+func (c *connector) Disconnect(ctx context.Context) error {
+	logger := c.logger.WithValues("method", "disconnect")
+	logger.Debug("Start Disconnect")
+
+	c.externalClientsByCreds.Range(func(key, value interface{}) bool {
+
+		ext := value.(*external)
+		ext.DecrementUsageCounter()
+		if ext.GetUsageCounter() < 0 {
+			ext.SetUsageCounter(0)
+		}
+
+		if ext.GetUsageCounter() == 0 && ext.service != nil {
+			ext.service.Close()
+			c.externalClientsByCreds.LoadAndDelete(key)
+			logger.Debug("Disconnected " + ext.id)
+		} else {
+			logger.Debug("Keep connection " + ext.id)
+		}
+
+		// this will continue iterating
+		return true
+	})
+
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service      temporalcloud.NamespaceService
+	kube         client.Client
+	logger       logging.Logger
+	id           string
+	usageCounter int
+	sync.RWMutex
+}
+
+func (c *external) GetUsageCounter() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.usageCounter
+}
+
+func (c *external) IncrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter++
+}
+
+func (c *external) DecrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter--
+}
+
+func (c *external) SetUsageCounter(usageCounter int) {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter = usageCounter
+}
+
+// connectionDetails returns the details, which are required by workers and
+// clients to connect to the namespace. They are published to the connection
+// secret or, if enabled, to an External Secret Store.
+func connectionDetails(observed *v1alpha1.CloudNamespaceObservation) managed.ConnectionDetails {
+	hostPort := observed.GrpcAddress
+	if hostPort == "" {
+		hostPort = observed.MtlsGrpcAddress
+	}
+
+	return managed.ConnectionDetails{
+		connectionDetailHostPort:  []byte(hostPort),
+		connectionDetailNamespace: []byte(observed.Namespace),
+	}
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	logger := c.logger.WithValues("method", "observe", "serviceId", c.id)
+	logger.Debug("Start observe")
+	cr, ok := mg.(*v1alpha1.CloudNamespace)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudNamespace)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	c.logger.Debug("ExternalName: '" + externalName + "'")
+
+	if externalName == "" {
+		c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	observed, err := c.service.DescribeNamespace(ctx, externalName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	if observed == nil {
+		c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	c.logger.Debug("Found '" + observed.Namespace + "' in state '" + observed.State + "'")
+
+	// Update Status
+	cr.Status.AtProvider = *observed
+
+	// Wait for the asynchronous operation of the last update or region change
+	pending, err := temporalcloud.ObserveAsyncOperation(ctx, c.service, &cr.Status.AsyncOperation)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errAsyncOperation)
+	}
+
+	if pending {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("Update of CloudNamespace is " + cr.Status.AsyncOperation.State))
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: connectionDetails(observed),
+		}, nil
+	}
+
+	// Temporal Cloud rejects changes while an operation is in progress,
+	// therefore the namespace is reported as up to date until it is active.
+	if observed.State != stateActive {
+		cr.SetConditions(xpv1.Unavailable().WithMessage("CloudNamespace is " + observed.State))
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: connectionDetails(observed),
+		}, nil
+	}
+
+	for _, regionStatus := range observed.RegionStatus {
+		if regionStatus.State != stateActive {
+			cr.SetConditions(xpv1.Unavailable().WithMessage("Region '" + regionStatus.Region + "' of CloudNamespace is " + regionStatus.State))
+			return managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: connectionDetails(observed),
+			}, nil
+		}
+	}
+
+	cr.SetConditions(xpv1.Available().WithMessage("CloudNamespace is active"))
+
+	observedCompareable, err := c.service.MapToNamespaceCompare(observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
+	}
+
+	spec, err := c.resolveParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	specCompareable, err := c.service.MapToNamespaceCompare(spec)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
+	}
+
+	diff := ""
+	resourceUpToDate := cmp.Equal(specCompareable, observedCompareable)
+
+	// Compare Spec with observed
+	if !resourceUpToDate {
+		diff = cmp.Diff(specCompareable, observedCompareable)
+	}
+	c.logger.Debug("Managed resource '" + cr.Name + "' upToDate: " + strconv.FormatBool(resourceUpToDate) + "")
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        resourceUpToDate,
+		Diff:                    diff,
+		ResourceLateInitialized: false,
+		ConnectionDetails:       connectionDetails(observed),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	logger := c.logger.WithValues("method", "create", "serviceId", c.id)
+	logger.Debug("Start create")
+	cr, ok := mg.(*v1alpha1.CloudNamespace)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudNamespace)
+	}
+
+	spec, err := c.resolveParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	namespace, err := c.service.CreateNamespace(ctx, spec)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, namespace)
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' created")
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	logger := c.logger.WithValues("method", "update", "serviceId", c.id)
+	logger.Debug("Start update")
+	cr, ok := mg.(*v1alpha1.CloudNamespace)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudNamespace)
+	}
+
+	region, add := nextRegionChange(cr.Spec.ForProvider.Regions, cr.Status.AtProvider.Regions)
+	if region != "" {
+		return managed.ExternalUpdate{}, c.changeRegion(ctx, cr, region, add)
+	}
+
+	spec, err := c.resolveParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The regions only differ in their order, which must not be changed
+	spec.Regions = cr.Status.AtProvider.Regions
+
+	asyncOperationId, err := c.service.UpdateNamespace(ctx, meta.GetExternalName(cr), cr.Status.AtProvider.ResourceVersion, spec)
+
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	asyncoperation.Track(&cr.Status.AsyncOperation, asyncOperationId)
+
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' updated")
+
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// resolveParameters returns a copy of the parameters, whose accepted client
+// CA bundle is read from the referenced secret. Unmanaged custom search
+// attributes are taken from the observation.
+func (c *external) resolveParameters(ctx context.Context, cr *v1alpha1.CloudNamespace) (*v1alpha1.CloudNamespaceParameters, error) {
+	spec := cr.Spec.ForProvider.DeepCopy()
+	if spec.CustomSearchAttributes == nil {
+		spec.CustomSearchAttributes = cr.Status.AtProvider.CustomSearchAttributes
+	}
+
+	if spec.MtlsAuth == nil || spec.MtlsAuth.AcceptedClientCaSecretRef == nil {
+		return spec, nil
+	}
+
+	acceptedClientCa, err := resource.ExtractSecret(ctx, c.kube, xpv1.CommonCredentialSelectors{SecretRef: spec.MtlsAuth.AcceptedClientCaSecretRef})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetClientCa)
+	}
+
+	if len(acceptedClientCa) == 0 {
+		return nil, errors.New(errEmptyClientCa)
+	}
+
+	spec.MtlsAuth.AcceptedClientCa = string(acceptedClientCa)
+	spec.MtlsAuth.AcceptedClientCaSecretRef = nil
+	return spec, nil
+}
+
+// changeRegion adds or removes the region and tracks the asynchronous
+// operation in the status until it completed.
+func (c *external) changeRegion(ctx context.Context, cr *v1alpha1.CloudNamespace, region string, add bool) error {
+	var asyncOperationId string
+	var err error
+	if add {
+		asyncOperationId, err = c.service.AddNamespaceRegion(ctx, meta.GetExternalName(cr), cr.Status.AtProvider.ResourceVersion, region)
+	} else {
+		asyncOperationId, err = c.service.DeleteNamespaceRegion(ctx, meta.GetExternalName(cr), cr.Status.AtProvider.ResourceVersion, region)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, errUpdate)
+	}
+
+	asyncoperation.Track(&cr.Status.AsyncOperation, asyncOperationId)
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' changes region '" + region + "' (add: " + strconv.FormatBool(add) + ")")
+	return nil
+}
+
+// nextRegionChange returns the next region, which must be added or removed
+// to replicate the namespace to the desired regions. Temporal Cloud changes
+// one region at a time. The region is empty, if no region must be changed.
+func nextRegionChange(desired []string, observed []string) (string, bool) {
+	for _, region := range desired {
+		if !contains(observed, region) {
+			return region, true
+		}
+	}
+
+	for _, region := range observed {
+		if !contains(desired, region) {
+			return region, false
+		}
+	}
+
+	return "", false
+}
+
+func contains(regions []string, region string) bool {
+	for _, r := range regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	logger := c.logger.WithValues("method", "delete", "serviceId", c.id)
+	logger.Debug("Start delete")
+	cr, ok := mg.(*v1alpha1.CloudNamespace)
+	if !ok {
+		return errors.New(errNotCloudNamespace)
+	}
+
+	if cr.Status.AtProvider.State == stateDeleting {
+		c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' is already being deleted")
+		return nil
+	}
+
+	err := c.service.DeleteNamespace(ctx, meta.GetExternalName(cr), cr.Status.AtProvider.ResourceVersion)
+
+	if err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' deleted")
+	return nil
+}
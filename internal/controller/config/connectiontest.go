@@ -0,0 +1,162 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+)
+
+const (
+	connectionTestTimeout = 1 * time.Minute
+
+	errGetPC            = "cannot get ProviderConfig"
+	errGetCreds         = "cannot get credentials"
+	errNewClient        = "cannot create new Service"
+	errGetSystemInfo    = "cannot get system info"
+	errUpdateStatus     = "cannot update ProviderConfig status"
+	errRemoveAnnotation = "cannot remove test connection annotation from ProviderConfig"
+
+	reasonConnectionTest event.Reason = "ConnectionTest"
+)
+
+// SetupConnectionTest adds a controller that tests the connection of
+// ProviderConfigs, which are annotated with the test connection annotation.
+func SetupConnectionTest(mgr ctrl.Manager, o controller.Options) error {
+	name := "connectiontest/" + strings.ToLower(v1alpha1.ProviderConfigGroupKind)
+
+	r := &connectionTester{
+		kube:         mgr.GetClient(),
+		newServiceFn: temporal.NewSystemInfoService,
+		logger:       o.Logger.WithValues("controller", name),
+		record:       event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ForControllerRuntime(o)).
+		For(&v1alpha1.ProviderConfig{}, builder.WithPredicates(resource.NewPredicates(hasTestConnectionAnnotation))).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+func hasTestConnectionAnnotation(obj runtime.Object) bool {
+	o, ok := obj.(metav1.Object)
+	return ok && o.GetAnnotations()[v1alpha1.AnnotationKeyTestConnection] == "true"
+}
+
+// A connectionTester dials the Temporal server of a ProviderConfig, requests
+// its system info and writes the result into the status of the ProviderConfig.
+// The latency covers both, the dial and the request.
+type connectionTester struct {
+	kube         client.Client
+	newServiceFn func(creds []byte) (temporal.SystemInfoService, error)
+	logger       logging.Logger
+	record       event.Recorder
+}
+
+func (r *connectionTester) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.logger.WithValues("request", req)
+	logger.Debug("Start connection test")
+
+	ctx, cancel := context.WithTimeout(ctx, connectionTestTimeout)
+	defer cancel()
+
+	pc := &v1alpha1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+
+	if !hasTestConnectionAnnotation(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	result := r.testConnection(ctx, pc)
+	orig := pc.DeepCopy()
+	pc.Status.ConnectionTest = result
+
+	if result.Error != "" {
+		logger.Debug("Connection test failed: " + result.Error)
+		r.record.Event(pc, event.Warning(reasonConnectionTest, errors.New(result.Error)))
+	} else {
+		logger.Debug("Connection test succeeded, server version: " + result.ServerVersion)
+		r.record.Event(pc, event.Normal(reasonConnectionTest, "Successfully connected to Temporal server version "+result.ServerVersion))
+	}
+
+	// The status is written first, so that the result is not lost, if the
+	// annotation can not be removed. Both are patched without a resource
+	// version, so that they do not conflict with each other or with other
+	// writers, and removing an already removed annotation is a no-op.
+	if err := r.kube.Status().Patch(ctx, pc, client.MergeFrom(orig)); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errUpdateStatus)
+	}
+
+	orig = pc.DeepCopy()
+	meta.RemoveAnnotations(pc, v1alpha1.AnnotationKeyTestConnection)
+	if err := r.kube.Patch(ctx, pc, client.MergeFrom(orig)); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errRemoveAnnotation)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *connectionTester) testConnection(ctx context.Context, pc *v1alpha1.ProviderConfig) *v1alpha1.ConnectionTestResult {
+	result := &v1alpha1.ConnectionTestResult{Time: metav1.Now()}
+
+	cd := pc.Spec.Credentials
+	creds, err := resource.CommonCredentialExtractor(ctx, cd.Source, r.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		result.Error = errors.Wrap(err, errGetCreds).Error()
+		return result
+	}
+
+	start := time.Now()
+	svc, err := r.newServiceFn(creds)
+	if err != nil {
+		result.Error = errors.Wrap(err, errNewClient).Error()
+		return result
+	}
+	defer svc.Close()
+
+	systemInfo, err := svc.GetSystemInfo(ctx)
+	if err != nil {
+		result.Error = errors.Wrap(err, errGetSystemInfo).Error()
+		return result
+	}
+
+	result.LatencyMilliseconds = time.Since(start).Milliseconds()
+	result.ServerVersion = systemInfo.ServerVersion
+	return result
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+type fakeSystemInfoService struct {
+	version string
+	err     error
+}
+
+func (s *fakeSystemInfoService) GetSystemInfo(_ context.Context) (*temporal.SystemInfo, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &temporal.SystemInfo{ServerVersion: s.version}, nil
+}
+
+func (s *fakeSystemInfoService) Close() {}
+
+// connectionTest is a connection test of a ProviderConfig, which records the
+// writes of the connectionTester.
+type connectionTest struct {
+	pc     *v1alpha1.ProviderConfig
+	writes []string
+	status *v1alpha1.ConnectionTestResult
+
+	patchErr error
+}
+
+func newConnectionTest(annotated bool) *connectionTest {
+	pc := &v1alpha1.ProviderConfig{}
+	pc.SetName("test")
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+	if annotated {
+		pc.SetAnnotations(map[string]string{v1alpha1.AnnotationKeyTestConnection: "true", "other": "kept"})
+	}
+	return &connectionTest{pc: pc}
+}
+
+func (c *connectionTest) reconcile(t *testing.T, newServiceFn func(creds []byte) (temporal.SystemInfoService, error)) error {
+	t.Helper()
+
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			c.pc.DeepCopyInto(obj.(*v1alpha1.ProviderConfig))
+			return nil
+		},
+		MockStatusPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+			c.writes = append(c.writes, "status")
+			c.status = obj.(*v1alpha1.ProviderConfig).Status.ConnectionTest
+			return nil
+		},
+		MockPatch: func(_ context.Context, obj client.Object, patch client.Patch, _ ...client.PatchOption) error {
+			c.writes = append(c.writes, "annotations")
+			data, err := patch.Data(obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if patch.Type() != types.MergePatchType || strings.Contains(string(data), "resourceVersion") {
+				t.Fatalf("Expected merge patch without resource version, got %s: %s", patch.Type(), data)
+			}
+			if string(data) != `{"metadata":{"annotations":{"`+v1alpha1.AnnotationKeyTestConnection+`":null}}}` {
+				t.Fatalf("Expected patch, which only removes the test connection annotation, got %s", data)
+			}
+			return c.patchErr
+		},
+	}
+
+	r := &connectionTester{
+		kube:         kube,
+		newServiceFn: newServiceFn,
+		logger:       logging.NewNopLogger(),
+		record:       event.NewNopRecorder(),
+	}
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "test"}})
+	return err
+}
+
+func TestConnectionTestSucceeded(t *testing.T) {
+	c := newConnectionTest(true)
+	err := c.reconcile(t, func(_ []byte) (temporal.SystemInfoService, error) {
+		// The dial is part of the latency
+		time.Sleep(20 * time.Millisecond)
+		return &fakeSystemInfoService{version: "1.22.0"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.status == nil || c.status.ServerVersion != "1.22.0" || c.status.Error != "" {
+		t.Fatalf("Expected successful connection test of server version 1.22.0, got %+v", c.status)
+	}
+	if c.status.LatencyMilliseconds < 20 {
+		t.Fatalf("Expected latency to include the dial, got %dms", c.status.LatencyMilliseconds)
+	}
+	if strings.Join(c.writes, ",") != "status,annotations" {
+		t.Fatalf("Expected status to be written before the annotation is removed, got %v", c.writes)
+	}
+}
+
+func TestConnectionTestFailed(t *testing.T) {
+	cases := map[string]func(creds []byte) (temporal.SystemInfoService, error){
+		"DialFailed": func(_ []byte) (temporal.SystemInfoService, error) {
+			return nil, errors.New("connection refused")
+		},
+		"RequestFailed": func(_ []byte) (temporal.SystemInfoService, error) {
+			return &fakeSystemInfoService{err: errors.New("connection refused")}, nil
+		},
+	}
+
+	for name, newServiceFn := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newConnectionTest(true)
+			if err := c.reconcile(t, newServiceFn); err != nil {
+				t.Fatal(err)
+			}
+
+			if c.status == nil || !strings.Contains(c.status.Error, "connection refused") || c.status.ServerVersion != "" {
+				t.Fatalf("Expected failed connection test, got %+v", c.status)
+			}
+			if strings.Join(c.writes, ",") != "status,annotations" {
+				t.Fatalf("Expected status to be written before the annotation is removed, got %v", c.writes)
+			}
+		})
+	}
+}
+
+func TestConnectionTestNotAnnotated(t *testing.T) {
+	c := newConnectionTest(false)
+	err := c.reconcile(t, func(_ []byte) (temporal.SystemInfoService, error) {
+		t.Fatal("Expected no connection test")
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.writes) != 0 {
+		t.Fatalf("Expected no writes, got %v", c.writes)
+	}
+}
+
+func TestConnectionTestAnnotationRemoval(t *testing.T) {
+	cases := map[string]struct {
+		patchErr error
+		wantErr  bool
+	}{
+		"Deleted": {patchErr: kerrors.NewNotFound(schema.GroupResource{}, "test")},
+		"Failed":  {patchErr: errors.New("boom"), wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := newConnectionTest(true)
+			c.patchErr = tc.patchErr
+			err := c.reconcile(t, func(_ []byte) (temporal.SystemInfoService, error) {
+				return &fakeSystemInfoService{version: "1.22.0"}, nil
+			})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error %v, got %v", tc.wantErr, err)
+			}
+
+			// The result is kept, even if the annotation can not be removed.
+			if c.status == nil || c.status.ServerVersion != "1.22.0" {
+				t.Fatalf("Expected status to be written, got %+v", c.status)
+			}
+		})
+	}
+}
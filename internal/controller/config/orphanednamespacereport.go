@@ -0,0 +1,189 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+)
+
+const (
+	orphanedNamespaceReportInterval = 10 * time.Minute
+	orphanedNamespaceReportTimeout  = 1 * time.Minute
+
+	errListNamespaces        = "cannot list namespaces"
+	errListTemporalNamespace = "cannot list TemporalNamespaces"
+
+	reasonOrphanedNamespaces event.Reason = "OrphanedNamespaces"
+)
+
+// SetupOrphanedNamespaceReport adds a controller that periodically reports the
+// namespaces of each ProviderConfig, which are not managed by any
+// TemporalNamespace. The controller is only added, if the feature is enabled.
+func SetupOrphanedNamespaceReport(mgr ctrl.Manager, o controller.Options) error {
+	if !o.Features.Enabled(features.EnableOrphanedNamespaceReport) {
+		return nil
+	}
+
+	name := "orphanednamespacereport/" + strings.ToLower(v1alpha1.ProviderConfigGroupKind)
+
+	r := &orphanedNamespaceReporter{
+		kube:         mgr.GetClient(),
+		newServiceFn: temporal.NewNamespaceService,
+		logger:       o.Logger.WithValues("controller", name),
+		record:       event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	// The report is written into the status, which must not trigger another
+	// report. Reports are repeated by requeueing instead.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ForControllerRuntime(o)).
+		For(&v1alpha1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+}
+
+// An orphanedNamespaceReporter lists the namespaces of the Temporal server of a
+// ProviderConfig and writes those, which are not managed by any
+// TemporalNamespace using the ProviderConfig, into its status.
+type orphanedNamespaceReporter struct {
+	kube         client.Client
+	newServiceFn func(creds []byte) (temporal.NamespaceService, error)
+	logger       logging.Logger
+	record       event.Recorder
+}
+
+func (r *orphanedNamespaceReporter) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.logger.WithValues("request", req)
+	logger.Debug("Start orphaned namespace report")
+
+	ctx, cancel := context.WithTimeout(ctx, orphanedNamespaceReportTimeout)
+	defer cancel()
+
+	pc := &v1alpha1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+
+	if pc.GetDeletionTimestamp() != nil {
+		return reconcile.Result{}, nil
+	}
+
+	report := r.report(ctx, pc)
+	pc.Status.OrphanedNamespaces = report
+
+	switch {
+	case report.Error != "":
+		logger.Debug("Orphaned namespace report failed: " + report.Error)
+		r.record.Event(pc, event.Warning(reasonOrphanedNamespaces, errors.New(report.Error)))
+	case len(report.Namespaces) > 0:
+		logger.Debug("Found orphaned namespaces: " + strings.Join(report.Namespaces, ", "))
+		r.record.Event(pc, event.Warning(reasonOrphanedNamespaces, errors.New("Namespaces are not managed by any TemporalNamespace: "+strings.Join(report.Namespaces, ", "))))
+	}
+
+	if err := r.kube.Status().Update(ctx, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errUpdateStatus)
+	}
+
+	return reconcile.Result{RequeueAfter: orphanedNamespaceReportInterval}, nil
+}
+
+func (r *orphanedNamespaceReporter) report(ctx context.Context, pc *v1alpha1.ProviderConfig) *v1alpha1.OrphanedNamespaceReport {
+	report := &v1alpha1.OrphanedNamespaceReport{Time: metav1.Now()}
+
+	managedNamespaces, err := r.managedNamespaces(ctx, pc)
+	if err != nil {
+		report.Error = errors.Wrap(err, errListTemporalNamespace).Error()
+		return report
+	}
+
+	cd := pc.Spec.Credentials
+	creds, err := resource.CommonCredentialExtractor(ctx, cd.Source, r.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		report.Error = errors.Wrap(err, errGetCreds).Error()
+		return report
+	}
+
+	svc, err := r.newServiceFn(creds)
+	if err != nil {
+		report.Error = errors.Wrap(err, errNewClient).Error()
+		return report
+	}
+	defer svc.Close()
+
+	namespaces, err := svc.ListAllNamespaces(ctx)
+	if err != nil {
+		report.Error = errors.Wrap(err, errListNamespaces).Error()
+		return report
+	}
+
+	for _, namespace := range namespaces {
+		if !managedNamespaces[namespace.Name] {
+			report.Namespaces = append(report.Namespaces, namespace.Name)
+		}
+	}
+	sort.Strings(report.Namespaces)
+	return report
+}
+
+// managedNamespaces returns the names of the namespaces, which are managed by
+// TemporalNamespaces using the ProviderConfig.
+func (r *orphanedNamespaceReporter) managedNamespaces(ctx context.Context, pc *v1alpha1.ProviderConfig) (map[string]bool, error) {
+	list := &core.TemporalNamespaceList{}
+	if err := r.kube.List(ctx, list); err != nil {
+		return nil, err
+	}
+
+	managedNamespaces := map[string]bool{}
+	for _, namespace := range list.Items {
+		ref := namespace.GetProviderConfigReference()
+		if ref == nil {
+			ref = namespace.GetProviderReference()
+		}
+		if ref != nil && ref.Name == pc.Name {
+			managedNamespaces[namespace.Spec.ForProvider.Name] = true
+			// Adopted namespaces are only named by their external name,
+			// until they are observed the first time
+			if externalName := meta.GetExternalName(&namespace); externalName != "" {
+				managedNamespaces[externalName] = true
+			}
+		}
+	}
+	return managedNamespaces, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	orphanedExternalName = "orphaned"
+	managedFinalizer     = "finalizer.managedresource.crossplane.io"
+)
+
+// setDeleted marks the managed resource as deleted by the API server.
+func setDeleted(mg resource.Managed, policy xpv1.DeletionPolicy) {
+	now := metav1.NewTime(time.Now())
+	mg.SetDeletionTimestamp(&now)
+	mg.SetDeletionPolicy(policy)
+	meta.AddFinalizer(mg, managedFinalizer)
+	meta.SetExternalName(mg, orphanedExternalName)
+}
+
+func TestOrphanedManagedResourcesAreNotConnected(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true}
+			mg := newManaged(t, s, gvk)
+			setDeleted(mg, xpv1.DeletionOrphan)
+			r, st := newReconciler(s, gvk, mg, e)
+
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			if e.calls() != 0 {
+				t.Fatalf("Expected no external calls of orphaned managed resource, got %+v", *e)
+			}
+			if meta.FinalizerExists(st.mg, managedFinalizer) {
+				t.Fatalf("Expected finalizer of orphaned managed resource to be removed")
+			}
+			if len(st.deleted) != 1 || st.deleted[0] != string(mg.GetUID()) {
+				t.Fatalf("Expected ProviderConfigUsage of orphaned managed resource to be deleted, got %v", st.deleted)
+			}
+		})
+	}
+}
+
+func TestDeletedManagedResourcesAreDeleted(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true}
+			mg := newManaged(t, s, gvk)
+			setDeleted(mg, xpv1.DeletionDelete)
+			r, _ := newReconciler(s, gvk, mg, e)
+
+			_, _ = r.Reconcile(context.Background(), testRequest)
+
+			if e.delete != 1 {
+				t.Fatalf("Expected external resource of deleted managed resource to be deleted, got %d deletes", e.delete)
+			}
+		})
+	}
+}
+
+func TestRecreatedManagedResourcesAdoptOrphans(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true}
+			orphaned := newManaged(t, s, gvk)
+			setDeleted(orphaned, xpv1.DeletionOrphan)
+			r, _ := newReconciler(s, gvk, orphaned, e)
+
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			// The managed resource is recreated with the external name of the
+			// orphaned managed resource
+			mg := newManaged(t, s, gvk)
+			mg.SetUID(types.UID("recreated-uid"))
+			meta.SetExternalName(mg, meta.GetExternalName(orphaned))
+			r, st := newReconciler(s, gvk, mg, e)
+
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			if e.create != 0 {
+				t.Fatalf("Expected orphaned external resource to be adopted instead of created, got %d creates", e.create)
+			}
+			if e.observed != orphanedExternalName {
+				t.Fatalf("Expected orphaned external resource %s to be observed, got %s", orphanedExternalName, e.observed)
+			}
+			if !meta.FinalizerExists(st.mg, managedFinalizer) {
+				t.Fatalf("Expected finalizer of recreated managed resource to be added")
+			}
+		})
+	}
+}
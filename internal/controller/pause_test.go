@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"go.temporal.io/api/serviceerror"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+func setPaused(mg resource.Managed, paused bool) {
+	if paused {
+		meta.AddAnnotations(mg, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+		return
+	}
+	meta.RemoveAnnotations(mg, meta.AnnotationKeyReconciliationPaused)
+}
+
+func TestPausedManagedResourcesAreNotConnected(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true}
+			r, st := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			setPaused(st.mg, true)
+			for i := 0; i < 2; i++ {
+				if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if e.calls() != 0 {
+				t.Fatalf("Expected no external calls of paused managed resource, got %+v", *e)
+			}
+			if reason := st.mg.GetCondition(xpv1.TypeSynced).Reason; reason != xpv1.ReasonReconcilePaused {
+				t.Fatalf("Expected Synced condition with reason %s, got %s", xpv1.ReasonReconcilePaused, reason)
+			}
+
+			setPaused(st.mg, false)
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			if e.connect != 1 || e.observe != 1 || e.update != 1 {
+				t.Fatalf("Expected external calls of resumed managed resource, got %+v", *e)
+			}
+
+			setPaused(st.mg, true)
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			if e.connect != 1 {
+				t.Fatalf("Expected no connect of paused managed resource, got %d", e.connect)
+			}
+		})
+	}
+}
+
+func TestResumeRetriesTerminalErrors(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true, updateErr: serviceerror.NewPermissionDenied("permission denied", "")}
+			r, st := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			// The update fails with a terminal error and is not retried
+			for i := 0; i < 2; i++ {
+				_, _ = r.Reconcile(context.Background(), testRequest)
+			}
+			if e.update != 1 {
+				t.Fatalf("Expected terminal error not to be retried, got %d updates", e.update)
+			}
+
+			setPaused(st.mg, true)
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			setPaused(st.mg, false)
+			_, _ = r.Reconcile(context.Background(), testRequest)
+
+			if e.update != 2 {
+				t.Fatalf("Expected terminal error to be retried after resume, got %d updates", e.update)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/denniskniep/provider-temporal/apis"
+	"github.com/denniskniep/provider-temporal/internal/backoff"
+	"github.com/denniskniep/provider-temporal/internal/drift"
+	"github.com/denniskniep/provider-temporal/internal/requestinfo"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	"github.com/denniskniep/provider-temporal/internal/usage"
+)
+
+// testPollInterval is the poll interval of the reconcilers of the tests.
+const testPollInterval = time.Minute
+
+// testRequest is the request of the managed resource of a test.
+var testRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "test"}}
+
+// store keeps the managed resource of a test between reconciles, like the
+// API server.
+type store struct {
+	mg      resource.Managed
+	deleted []string
+}
+
+func (s *store) get(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	data, err := json.Marshal(s.mg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, obj)
+}
+
+func (s *store) update(_ context.Context, obj client.Object) error {
+	s.mg = obj.DeepCopyObject().(resource.Managed)
+	return nil
+}
+
+func (s *store) delete(_ context.Context, obj client.Object) error {
+	s.deleted = append(s.deleted, obj.GetName())
+	return nil
+}
+
+// fakeExternal is the external resource of a test. It counts the calls of
+// the external client.
+type fakeExternal struct {
+	exists     bool
+	connectErr error
+	observeErr error
+	createErr  error
+	updateErr  error
+
+	// stuck blocks the observe until the reconcile is canceled
+	stuck bool
+
+	// observed is the external name of the last observed managed resource
+	observed string
+
+	// events are the events, which the reconciler recorded
+	events []event.Event
+
+	connect int
+	observe int
+	create  int
+	update  int
+	delete  int
+}
+
+func (e *fakeExternal) calls() int {
+	return e.connect + e.observe + e.create + e.update + e.delete
+}
+
+// recorder records the events of a test in its external resource.
+type recorder struct {
+	e *fakeExternal
+}
+
+func (r *recorder) Event(_ runtime.Object, ev event.Event) {
+	r.e.events = append(r.e.events, ev)
+}
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+// newScheme returns a scheme with all kinds of the provider.
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// managedKinds returns the kinds of all managed resources of the provider.
+func managedKinds(t *testing.T, s *runtime.Scheme) []schema.GroupVersionKind {
+	t.Helper()
+
+	var kinds []schema.GroupVersionKind
+	for gvk := range s.AllKnownTypes() {
+		obj, err := s.New(gvk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := obj.(resource.Managed); ok {
+			kinds = append(kinds, gvk)
+		}
+	}
+
+	if len(kinds) == 0 {
+		t.Fatal("Expected managed kinds in the scheme")
+	}
+	return kinds
+}
+
+// newManaged returns a managed resource of the kind.
+func newManaged(t *testing.T, s *runtime.Scheme, gvk schema.GroupVersionKind) resource.Managed {
+	t.Helper()
+
+	obj, err := s.New(gvk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mg := obj.(resource.Managed)
+	mg.SetName(testRequest.Name)
+	mg.SetUID(types.UID("test-uid"))
+	mg.SetGeneration(1)
+	return mg
+}
+
+// newReconciler returns a reconciler of the managed resource of the kind,
+// whose external client and finalizer are wrapped like in the controllers.
+// The options are applied after the options of the controllers.
+func newReconciler(s *runtime.Scheme, gvk schema.GroupVersionKind, mg resource.Managed, e *fakeExternal, o ...managed.ReconcilerOption) (reconcile.Reconciler, *store) {
+	st := &store{mg: mg}
+
+	kube := &test.MockClient{
+		MockGet: st.get,
+		MockUpdate: func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+			return st.update(ctx, obj)
+		},
+		MockStatusUpdate: func(ctx context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+			return st.update(ctx, obj)
+		},
+		MockDelete: func(ctx context.Context, obj client.Object, _ ...client.DeleteOption) error {
+			return st.delete(ctx, obj)
+		},
+	}
+
+	external := managed.ExternalClientFns{
+		ObserveFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+			e.observe++
+			e.observed = meta.GetExternalName(mg)
+			if e.stuck {
+				<-ctx.Done()
+				return managed.ExternalObservation{}, ctx.Err()
+			}
+			return managed.ExternalObservation{ResourceExists: e.exists, ResourceUpToDate: false}, e.observeErr
+		},
+		CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			e.create++
+			return managed.ExternalCreation{}, e.createErr
+		},
+		UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			e.update++
+			return managed.ExternalUpdate{}, e.updateErr
+		},
+		DeleteFn: func(_ context.Context, _ resource.Managed) error {
+			e.delete++
+			return nil
+		},
+	}
+
+	connector := managed.ExternalConnectDisconnecterFns{
+		ConnectFn: func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			e.connect++
+			if e.connectErr != nil {
+				return nil, e.connectErr
+			}
+			return external, nil
+		},
+		DisconnectFn: func(_ context.Context) error {
+			return nil
+		},
+	}
+
+	exhausted := backoff.NewTracker()
+	terminated := terminal.NewTracker()
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnectDisconnecter(exhausted.NewConnector(terminated.NewConnector(timeout.NewConnector(requestinfo.NewConnector(drift.NewConnector(event.NewNopRecorder(), connector)))))),
+		managed.WithFinalizer(usage.NewFinalizer(kube)),
+		managed.WithRecorder(&recorder{e: e}),
+		timeout.WithSyncTimeout(),
+	}
+	r := managed.NewReconciler(&fake.Manager{Client: kube, Scheme: s}, resource.ManagedKind(gvk), append(opts, o...)...)
+	return exhausted.NewReconciler(terminated.NewReconciler(r, testPollInterval)), st
+}
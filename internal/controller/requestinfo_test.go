@@ -0,0 +1,57 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/googleapis/google/rpc"
+	"github.com/gogo/status"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+)
+
+func TestFailedRequestEventsIncludeCodeAndRequestId(t *testing.T) {
+	s := newScheme(t)
+
+	st, err := status.New(codes.Unavailable, "frontend unavailable").WithDetails(&rpc.RequestInfo{RequestId: "req-123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true, updateErr: serviceerror.FromStatus(st)}
+			r, _ := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, ev := range e.events {
+				if ev.Type == event.TypeWarning && strings.Contains(ev.Message, "frontend unavailable (gRPC code: Unavailable, request id: req-123)") {
+					return
+				}
+			}
+			t.Fatalf("Expected warning event with gRPC code and request id, got %+v", e.events)
+		})
+	}
+}
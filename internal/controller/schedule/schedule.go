@@ -0,0 +1,520 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/syncmap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/backoff"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+	"github.com/denniskniep/provider-temporal/internal/drift"
+	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/pollinterval"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+	"github.com/denniskniep/provider-temporal/internal/requestinfo"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	"github.com/denniskniep/provider-temporal/internal/usage"
+)
+
+const (
+	errNotSchedule      = "managed resource is not a Schedule custom resource"
+	errTrackPCUsage     = "cannot track ProviderConfig usage"
+	errGetPC            = "cannot get ProviderConfig"
+	errGetCreds         = "cannot get credentials"
+	errDescribe         = "failed to describe Schedule resource"
+	errNewClient        = "cannot create new Service"
+	errMapping          = "failed to map Schedule resource as comparable"
+	errCreate           = "failed to create Schedule resource"
+	errUpdate           = "failed to update Schedule resource"
+	errDelete           = "failed to delete Schedule resource"
+	errTrigger          = "failed to trigger Schedule resource"
+	errRemoveAnnotation = "cannot remove trigger-now annotation from Schedule"
+)
+
+// Setup adds a controller that reconciles Schedule managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	o.Logger.Info("Setup Controller: Schedule")
+	name := managed.ControllerName(v1alpha1.ScheduleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	exhausted := backoff.NewTracker()
+	terminated := terminal.NewTracker()
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnectDisconnecter(exhausted.NewConnector(terminated.NewConnector(timeout.NewConnector(requestinfo.NewConnector(drift.NewConnector(recorder, &connector{
+			externalClientsByCreds: syncmap.Map{},
+			kube:                   mgr.GetClient(),
+			usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:           temporal.NewScheduleService,
+			logger:                 o.Logger.WithValues("controller", name)})))))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		pollinterval.WithHook(),
+		timeout.WithSyncTimeout(),
+		managed.WithRecorder(recorder),
+		managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient())),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.ScheduleGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ForControllerRuntime(o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.Schedule{}).
+		Complete(ratelimiter.NewReconciler(name, exhausted.NewReconciler(terminated.NewReconciler(r, o.PollInterval)), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube                   client.Client
+	usage                  resource.Tracker
+	logger                 logging.Logger
+	externalClientsByCreds syncmap.Map
+	newServiceFn           func(creds []byte) (temporal.ScheduleService, error)
+}
+
+func hash(content []byte) string {
+	h := sha256.New()
+	h.Write(content)
+	sha := h.Sum(nil)
+	shaStr := hex.EncodeToString(sha)
+	return shaStr
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	logger := c.logger.WithValues("method", "connect")
+	logger.Debug("Start Connect")
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return nil, errors.New(errNotSchedule)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	creds, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	credHash := hash(creds)
+
+	svc, err := c.newServiceFn(creds)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	ext := &external{service: svc, kube: c.kube, logger: c.logger, id: uuid.New().String()}
+	value, ok := c.externalClientsByCreds.LoadOrStore(credHash, ext)
+	if ok {
+		ext.service.Close()
+		ext = value.(*external)
+		logger.Debug("Use existing " + ext.id)
+	} else {
+		logger.Debug("Connected " + ext.id)
+	}
+
+	ext.IncrementUsageCounter()
+	return ext, nil
+}
+
+func (c *connector) Disconnect(ctx context.Context) error {
+	logger := c.logger.WithValues("method", "disconnect")
+	logger.Debug("Start Disconnect")
+
+	c.externalClientsByCreds.Range(func(key, value interface{}) bool {
+
+		ext := value.(*external)
+		ext.DecrementUsageCounter()
+		if ext.GetUsageCounter() < 0 {
+			ext.SetUsageCounter(0)
+		}
+
+		if ext.GetUsageCounter() == 0 && ext.service != nil {
+			ext.service.Close()
+			c.externalClientsByCreds.LoadAndDelete(key)
+			logger.Debug("Disconnected " + ext.id)
+		} else {
+			logger.Debug("Keep connection " + ext.id)
+		}
+
+		// this will continue iterating
+		return true
+	})
+
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service      temporal.ScheduleService
+	kube         client.Client
+	logger       logging.Logger
+	id           string
+	usageCounter int
+	sync.RWMutex
+}
+
+func (c *external) GetUsageCounter() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.usageCounter
+}
+
+func (c *external) IncrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter++
+}
+
+func (c *external) DecrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter--
+}
+
+func (c *external) SetUsageCounter(usageCounter int) {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter = usageCounter
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	logger := c.logger.WithValues("method", "observe", "serviceId", c.id)
+	logger.Debug("Start observe")
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSchedule)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	c.logger.Debug("ExternalName: '" + externalName + "'")
+
+	if cr.Spec.ForProvider.TemporalNamespaceName == nil {
+		return managed.ExternalObservation{}, errors.New("TemporalNamespaceName not set")
+	}
+
+	observed, err := c.service.DescribeScheduleById(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, cr.Spec.ForProvider.ScheduleId)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	if observed == nil {
+		c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	c.logger.Debug("Found '" + observed.ScheduleId + "' in namespace '" + observed.TemporalNamespaceName + "'")
+
+	specCalendarsHash, err := calendarsHash(&cr.Spec.ForProvider.Spec)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
+	}
+
+	// The calendars are applied by Create and Update, but the status written
+	// there might not be persisted. Without a recorded hash the calendars are
+	// therefore assumed to be up to date.
+	observed.AppliedCalendarsHash = cr.Status.AtProvider.AppliedCalendarsHash
+	if observed.AppliedCalendarsHash == "" {
+		observed.AppliedCalendarsHash = specCalendarsHash
+	}
+
+	// Same applies to the remaining actions.
+	observed.AppliedRemainingActions = cr.Status.AtProvider.AppliedRemainingActions
+	if observed.AppliedRemainingActions == nil {
+		observed.AppliedRemainingActions = &cr.Spec.ForProvider.State.RemainingActions
+	}
+
+	observed.LastTriggerTime = cr.Status.AtProvider.LastTriggerTime
+	if triggerTime, err := c.triggerIfAnnotated(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	} else if triggerTime != nil {
+		observed.LastTriggerTime = triggerTime
+	}
+
+	// Update Status
+	cr.Status.AtProvider = *observed
+	cr.SetConditions(xpv1.Available().WithMessage("Schedule exists"))
+
+	observedCompareable, err := c.service.MapToScheduleCompare(observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
+	}
+
+	specCompareable, err := c.service.MapToScheduleCompare(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
+	}
+
+	diff := ""
+	resourceUpToDate := cmp.Equal(specCompareable, observedCompareable)
+
+	// Compare Spec with observed
+	if !resourceUpToDate {
+		diff = cmp.Diff(specCompareable, observedCompareable)
+	}
+
+	if specCalendarsHash != observed.AppliedCalendarsHash {
+		resourceUpToDate = false
+		diff += "calendars, structuredCalendars, cronExpressions, excludeCalendars or excludeStructuredCalendars changed\n"
+	}
+
+	if remainingActionsChanged(cr) {
+		resourceUpToDate = false
+		diff += "remainingActions changed\n"
+	}
+	c.logger.Debug("Managed resource '" + cr.Name + "' upToDate: " + strconv.FormatBool(resourceUpToDate) + "")
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        resourceUpToDate,
+		Diff:                    diff,
+		ResourceLateInitialized: false,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	logger := c.logger.WithValues("method", "create", "serviceId", c.id)
+	logger.Debug("Start create")
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSchedule)
+	}
+
+	err := c.service.CreateSchedule(ctx, &cr.Spec.ForProvider)
+
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.TemporalNamespaceName+"."+cr.Spec.ForProvider.ScheduleId)
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' created")
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	logger := c.logger.WithValues("method", "update", "serviceId", c.id)
+	logger.Debug("Start update")
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSchedule)
+	}
+
+	specCalendarsHash, err := calendarsHash(&cr.Spec.ForProvider.Spec)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errMapping)
+	}
+
+	onlyPausedChanged, err := c.onlyPausedChanged(cr, specCalendarsHash)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errMapping)
+	}
+
+	switch {
+	case onlyPausedChanged && cr.Spec.ForProvider.State.Paused:
+		err = c.service.PauseSchedule(ctx, &cr.Spec.ForProvider)
+	case onlyPausedChanged:
+		err = c.service.UnpauseSchedule(ctx, &cr.Spec.ForProvider)
+	default:
+		err = c.service.UpdateSchedule(ctx, c.scheduleToUpdate(cr))
+	}
+
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	cr.Status.AtProvider.AppliedCalendarsHash = specCalendarsHash
+	remainingActions := cr.Spec.ForProvider.State.RemainingActions
+	cr.Status.AtProvider.AppliedRemainingActions = &remainingActions
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' updated")
+
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	logger := c.logger.WithValues("method", "delete", "serviceId", c.id)
+	logger.Debug("Start delete")
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return errors.New(errNotSchedule)
+	}
+
+	err := c.service.DeleteScheduleById(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, cr.Spec.ForProvider.ScheduleId)
+
+	if err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' deleted")
+	return nil
+}
+
+// triggerIfAnnotated triggers the Schedule, if it is annotated with the
+// trigger-now annotation. The annotation is removed before the Schedule is
+// triggered, so that each annotation triggers the Schedule at most once.
+func (c *external) triggerIfAnnotated(ctx context.Context, cr *v1alpha1.Schedule) (*metav1.Time, error) {
+	overlapPolicy, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyTriggerNow]
+	if !ok {
+		return nil, nil
+	}
+
+	meta.RemoveAnnotations(cr, v1alpha1.AnnotationKeyTriggerNow)
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return nil, errors.Wrap(err, errRemoveAnnotation)
+	}
+
+	if overlapPolicy == "true" {
+		overlapPolicy = ""
+	}
+
+	err := c.service.TriggerScheduleById(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, cr.Spec.ForProvider.ScheduleId, overlapPolicy)
+	if err != nil {
+		return nil, errors.Wrap(err, errTrigger)
+	}
+
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' triggered")
+	now := metav1.Now()
+	return &now, nil
+}
+
+// onlyPausedChanged returns true, if the desired state differs from the
+// observed state only in the paused flag. Such a change is applied with a
+// pause or unpause patch instead of updating the whole Schedule.
+func (c *external) onlyPausedChanged(cr *v1alpha1.Schedule, specCalendarsHash string) (bool, error) {
+	if specCalendarsHash != cr.Status.AtProvider.AppliedCalendarsHash || remainingActionsChanged(cr) {
+		return false, nil
+	}
+
+	observedCompareable, err := c.service.MapToScheduleCompare(&cr.Status.AtProvider)
+	if err != nil {
+		return false, err
+	}
+
+	specCompareable, err := c.service.MapToScheduleCompare(&cr.Spec.ForProvider)
+	if err != nil {
+		return false, err
+	}
+
+	if specCompareable.State.Paused == observedCompareable.State.Paused {
+		return false, nil
+	}
+
+	specCompareable.State = observedCompareable.State
+	return cmp.Equal(specCompareable, observedCompareable), nil
+}
+
+// remainingActionsChanged returns true, if the desired remaining actions differ
+// from the remaining actions, which were applied last.
+func remainingActionsChanged(cr *v1alpha1.Schedule) bool {
+	applied := cr.Status.AtProvider.AppliedRemainingActions
+	return applied != nil && *applied != cr.Spec.ForProvider.State.RemainingActions
+}
+
+// scheduleToUpdate returns the desired Schedule. Unless the desired remaining
+// actions changed, it keeps the observed remaining actions, which the server
+// decreased with every action.
+func (c *external) scheduleToUpdate(cr *v1alpha1.Schedule) *v1alpha1.ScheduleParameters {
+	schedule := cr.Spec.ForProvider.DeepCopy()
+	if !remainingActionsChanged(cr) {
+		schedule.State.RemainingActions = cr.Status.AtProvider.State.RemainingActions
+	}
+	return schedule
+}
+
+// calendarsHash returns the hash of the fields of a ScheduleSpec, which are
+// normalized by the server.
+func calendarsHash(spec *v1alpha1.ScheduleSpec) (string, error) {
+	calendars, err := json.Marshal(v1alpha1.ScheduleSpec{
+		Calendars:                  spec.Calendars,
+		StructuredCalendars:        spec.StructuredCalendars,
+		CronExpressions:            spec.CronExpressions,
+		ExcludeCalendars:           spec.ExcludeCalendars,
+		ExcludeStructuredCalendars: spec.ExcludeStructuredCalendars,
+	})
+	if err != nil {
+		return "", err
+	}
+	return hash(calendars), nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package searchattribute
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+const (
+	errNamespacesNotReady = "SearchAttribute is not ready in namespaces %s"
+	errNamespaceConflict  = "exists with type %s"
+	errNamespaceFailed    = "namespace %q"
+
+	// msgNotExists is the message of a namespace, where the SearchAttribute
+	// does not exist yet.
+	msgNotExists = "does not exist"
+)
+
+// inMultipleNamespaces returns true, if the SearchAttribute is created in
+// multiple namespaces.
+func inMultipleNamespaces(cr *v1alpha1.SearchAttribute) bool {
+	parameters := cr.Spec.ForProvider
+	return len(parameters.TemporalNamespaceNames) > 0 || len(parameters.TemporalNamespaceNamesRefs) > 0 || parameters.TemporalNamespaceNamesSelector != nil
+}
+
+// observeMultipleNamespaces observes the SearchAttribute in each namespace of
+// the spec and in each managed namespace, which is no longer part of the spec.
+func (c *external) observeMultipleNamespaces(ctx context.Context, cr *v1alpha1.SearchAttribute) (managed.ExternalObservation, error) {
+	parameters := cr.Spec.ForProvider
+
+	// Temporal Cloud can not remove search attributes, therefore they are kept
+	// when the managed resource is deleted
+	if c.cloud && meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	upToDate := true
+	var observations []v1alpha1.SearchAttributeNamespaceObservation
+	var notReady []string
+	var created []string
+
+	for _, namespace := range sortedNamespaces(parameters.TemporalNamespaceNames) {
+		observation := v1alpha1.SearchAttributeNamespaceObservation{TemporalNamespaceName: namespace}
+		observed, err := c.service.DescribeSearchAttributeByName(ctx, namespace, parameters.Name)
+
+		switch {
+		case temporal.IsNamespaceUnavailable(err):
+			// Nothing can be created until the namespace is registered
+			observation.Message = errors.Errorf(errWaitingForNamespace, namespace).Error()
+		case err != nil:
+			return managed.ExternalObservation{}, errors.Wrap(errors.Wrapf(err, errNamespaceFailed, namespace), errDescribe)
+		case observed == nil:
+			upToDate = false
+			observation.Message = msgNotExists
+		case observed.Type != parameters.Type:
+			// The type can not be changed, therefore it is not retried
+			observation.Type = observed.Type
+			observation.Message = errors.Errorf(errNamespaceConflict, observed.Type).Error()
+		default:
+			observation.Type = observed.Type
+			created = append(created, namespace)
+		}
+
+		if observation.Message != "" {
+			notReady = append(notReady, namespace)
+		}
+		observations = append(observations, observation)
+	}
+
+	removed, err := c.removedNamespaces(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if len(removed) > 0 {
+		upToDate = false
+	}
+
+	cr.Status.AtProvider = v1alpha1.SearchAttributeObservation{
+		Name:       parameters.Name,
+		Type:       parameters.Type,
+		Namespaces: observations,
+	}
+
+	if len(notReady) > 0 {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errors.Errorf(errNamespacesNotReady, strings.Join(notReady, ", ")).Error()))
+	} else {
+		cr.SetConditions(xpv1.Available().WithMessage("SearchAttribute exists in all namespaces"))
+	}
+
+	if upToDate {
+		cr.Status.ManagedTemporalNamespaceNames = created
+	}
+
+	exists := meta.GetExternalName(cr) != ""
+	if meta.WasDeleted(cr) {
+		managedNamespaces, err := c.existingManagedNamespaces(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		exists = len(managedNamespaces) > 0
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    exists,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// updateMultipleNamespaces removes the SearchAttribute from the managed
+// namespaces, which are no longer part of the spec, and adds it to each
+// namespace of the spec, where it does not exist.
+func (c *external) updateMultipleNamespaces(ctx context.Context, cr *v1alpha1.SearchAttribute) error {
+	parameters := cr.Spec.ForProvider
+
+	removed, err := c.removedNamespaces(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range removed {
+		err := c.service.DeleteSearchAttributeByName(ctx, namespace, parameters.Name)
+		if err != nil && !temporal.IsNamespaceUnavailable(err) {
+			return errors.Wrapf(err, errNamespaceFailed, namespace)
+		}
+	}
+
+	for _, observation := range cr.Status.AtProvider.Namespaces {
+		if observation.Type != "" || observation.Message != msgNotExists {
+			continue
+		}
+
+		namespace := observation.TemporalNamespaceName
+		searchAttribute := parameters.DeepCopy()
+		searchAttribute.TemporalNamespaceName = &namespace
+		if err := c.service.CreateSearchAttribute(ctx, searchAttribute); err != nil {
+			return errors.Wrapf(err, errNamespaceFailed, namespace)
+		}
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		meta.SetExternalName(cr, parameters.Name)
+	}
+	return nil
+}
+
+// deleteMultipleNamespaces removes the SearchAttribute from all managed
+// namespaces.
+func (c *external) deleteMultipleNamespaces(ctx context.Context, cr *v1alpha1.SearchAttribute) error {
+	managedNamespaces, err := c.existingManagedNamespaces(ctx, cr)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range managedNamespaces {
+		err := c.service.DeleteSearchAttributeByName(ctx, namespace, cr.Spec.ForProvider.Name)
+		if err != nil && !temporal.IsNamespaceUnavailable(err) {
+			return errors.Wrapf(err, errNamespaceFailed, namespace)
+		}
+	}
+	return nil
+}
+
+// removedNamespaces returns the managed namespaces, which are no longer part
+// of the spec and still contain the SearchAttribute.
+func (c *external) removedNamespaces(ctx context.Context, cr *v1alpha1.SearchAttribute) ([]string, error) {
+	managedNamespaces, err := c.existingManagedNamespaces(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for _, namespace := range managedNamespaces {
+		if !containsNamespace(cr.Spec.ForProvider.TemporalNamespaceNames, namespace) || meta.WasDeleted(cr) {
+			removed = append(removed, namespace)
+		}
+	}
+	return removed, nil
+}
+
+// existingManagedNamespaces returns the managed namespaces, which still
+// contain the SearchAttribute with its type. SearchAttributes with another
+// type were not created by the SearchAttribute and are never removed.
+func (c *external) existingManagedNamespaces(ctx context.Context, cr *v1alpha1.SearchAttribute) ([]string, error) {
+	var existing []string
+	for _, namespace := range cr.Status.ManagedTemporalNamespaceNames {
+		observed, err := c.service.DescribeSearchAttributeByName(ctx, namespace, cr.Spec.ForProvider.Name)
+		if temporal.IsNamespaceUnavailable(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(errors.Wrapf(err, errNamespaceFailed, namespace), errDescribe)
+		}
+
+		if observed != nil && observed.Type == cr.Spec.ForProvider.Type {
+			existing = append(existing, namespace)
+		}
+	}
+	return existing, nil
+}
+
+func sortedNamespaces(namespaces []string) []string {
+	sorted := append([]string{}, namespaces...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func containsNamespace(namespaces []string, namespace string) bool {
+	for _, n := range namespaces {
+		if n == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -21,6 +21,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/google/go-cmp/cmp"
@@ -29,7 +30,12 @@ import (
 	"golang.org/x/sync/syncmap"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlevent "sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
@@ -43,21 +49,64 @@ import (
 
 	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
 	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/backoff"
 	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+	temporalcloud "github.com/denniskniep/provider-temporal/internal/clients/cloud"
+	"github.com/denniskniep/provider-temporal/internal/drift"
 	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/pollinterval"
+	"github.com/denniskniep/provider-temporal/internal/providerref"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+	"github.com/denniskniep/provider-temporal/internal/requestinfo"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	"github.com/denniskniep/provider-temporal/internal/usage"
 )
 
 const (
-	errNotSearchAttribute = "managed resource is not a SearchAttribute custom resource"
-	errTrackPCUsage       = "cannot track ProviderConfig usage"
-	errGetPC              = "cannot get ProviderConfig"
-	errGetCreds           = "cannot get credentials"
-	errDescribe           = "failed to describe SearchAttribute resource"
-	errNewClient          = "cannot create new Service"
-	errMapping            = "failed to map SearchAttribute resource as comparable"
-	errCreate             = "failed to create SearchAttribute resource"
-	errUpdate             = "failed to update SearchAttribute resource"
-	errDelete             = "failed to delete SearchAttribute resource"
+	errNotSearchAttribute    = "managed resource is not a SearchAttribute custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errGetPC                 = "cannot get ProviderConfig"
+	errGetCreds              = "cannot get credentials"
+	errDescribe              = "failed to describe SearchAttribute resource"
+	errNewClient             = "cannot create new Service"
+	errMapping               = "failed to map SearchAttribute resource as comparable"
+	errCreate                = "failed to create SearchAttribute resource"
+	errUpdate                = "failed to update SearchAttribute resource"
+	errDelete                = "failed to delete SearchAttribute resource"
+	errRecreate              = "failed to recreate SearchAttribute resource"
+	errRename                = "failed to rename SearchAttribute resource"
+	errRenamed               = "SearchAttribute %q was renamed to %q by removing and adding it. Workflow executions keep their values of %q, which are neither searchable by %q nor migrated"
+	errImmutable             = "SearchAttribute %q can not be updated, because all properties are immutable. Set allowRecreate to change its type"
+	errUnsupported           = "SearchAttribute is not supported by the Temporal server and is not created until it is recreated: %s"
+	errLimitReached          = "limit of %d custom SearchAttributes of type %s is reached in namespace %q and the SearchAttribute is not created until it is changed or recreated: %s"
+	errSystemSearchAttribute = "%q is a system SearchAttribute of Temporal, which is neither created nor deleted"
+	errWaitingForNamespace   = "waiting for namespace %q to be registered"
+	errNameRequired          = "name or external name is required"
+	errTypeRequired          = "type is required to create a SearchAttribute"
+	errInvalidExternalName   = "external name %q is not of the form <namespace>.<name>"
+	errExternalNameMismatch  = "%s %q does not match external name %q"
+
+	// reasonRenamed indicates that a SearchAttribute was renamed by removing
+	// and adding it.
+	reasonRenamed event.Reason = "Renamed"
+
+	// reasonUnsupported indicates that the Temporal server rejected the
+	// SearchAttribute, because it does not support it.
+	reasonUnsupported xpv1.ConditionReason = "Unsupported"
+
+	// reasonLimitReached indicates that the Temporal server rejected the
+	// SearchAttribute, because the namespace already has the maximum number of
+	// custom SearchAttributes of its type.
+	reasonLimitReached xpv1.ConditionReason = "LimitReached"
+
+	// reasonSystemSearchAttribute indicates that the name of the
+	// SearchAttribute belongs to a SearchAttribute predefined by Temporal.
+	reasonSystemSearchAttribute xpv1.ConditionReason = "SystemSearchAttribute"
+
+	// reasonWaitingForNamespace indicates that the SearchAttribute is not
+	// created yet, because its namespace does not exist or is not registered.
+	reasonWaitingForNamespace xpv1.ConditionReason = "WaitingForNamespace"
 )
 
 // Setup adds a controller that reconciles SearchAttribute managed resources.
@@ -65,32 +114,126 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	o.Logger.Info("Setup Controller: SearchAttribute")
 	name := managed.ControllerName(v1alpha1.SearchAttributeGroupKind)
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
-	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.SearchAttributeGroupVersionKind),
-		managed.WithExternalConnectDisconnecter(&connector{
+	exhausted := backoff.NewTracker()
+	terminated := terminal.NewTracker()
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnectDisconnecter(exhausted.NewConnector(terminated.NewConnector(timeout.NewConnector(requestinfo.NewConnector(drift.NewConnector(recorder, &connector{
 			externalClientsByCreds: syncmap.Map{},
 			kube:                   mgr.GetClient(),
 			usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
 			newServiceFn:           temporal.NewSearchAttributeService,
-			logger:                 o.Logger.WithValues("controller", name)}),
+			newCloudServiceFn:      temporalcloud.NewSearchAttributeService,
+			record:                 recorder,
+			logger:                 o.Logger.WithValues("controller", name)})))))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithInitializers(),
-		managed.WithConnectionPublishers(cps...))
+		pollinterval.WithHook(),
+		timeout.WithSyncTimeout(),
+		managed.WithRecorder(recorder),
+		managed.WithInitializers(providerref.NewInitializer(mgr.GetClient())),
+		managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient())),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.SearchAttributeGroupVersionKind), opts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.SearchAttribute{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		WithOptions(ratelimit.ForControllerRuntime(o)).
+		For(&v1alpha1.SearchAttribute{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&v1alpha1.TemporalNamespace{},
+			handler.EnqueueRequestsFromMapFunc(searchAttributesOfNamespace(mgr.GetClient())),
+			builder.WithPredicates(namespaceBecameReady())).
+		Complete(ratelimiter.NewReconciler(name, exhausted.NewReconciler(terminated.NewReconciler(r, o.PollInterval)), o.GlobalRateLimiter))
+}
+
+// namespaceBecameReady only lets updates pass, in which a TemporalNamespace
+// becomes ready, i.e. its namespace is registered in Temporal.
+func namespaceBecameReady() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc:  func(ctrlevent.CreateEvent) bool { return false },
+		DeleteFunc:  func(ctrlevent.DeleteEvent) bool { return false },
+		GenericFunc: func(ctrlevent.GenericEvent) bool { return false },
+		UpdateFunc: func(e ctrlevent.UpdateEvent) bool {
+			oldNamespace, ok := e.ObjectOld.(*v1alpha1.TemporalNamespace)
+			if !ok {
+				return false
+			}
+			newNamespace, ok := e.ObjectNew.(*v1alpha1.TemporalNamespace)
+			if !ok {
+				return false
+			}
+			return !isReady(oldNamespace) && isReady(newNamespace)
+		},
+	}
+}
+
+func isReady(cr *v1alpha1.TemporalNamespace) bool {
+	return cr.GetCondition(xpv1.TypeReady).Status == xpv1.Available().Status
+}
+
+// searchAttributesOfNamespace maps a TemporalNamespace to the SearchAttributes
+// which belong to it, so that SearchAttributes created before their namespace
+// was registered are reconciled immediately instead of after the next poll.
+func searchAttributesOfNamespace(kube client.Client) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		namespace, ok := obj.(*v1alpha1.TemporalNamespace)
+		if !ok {
+			return nil
+		}
+
+		searchAttributes := &v1alpha1.SearchAttributeList{}
+		if err := kube.List(ctx, searchAttributes); err != nil {
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range searchAttributes.Items {
+			if belongsToNamespace(&searchAttributes.Items[i], namespace) {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: searchAttributes.Items[i].GetName()},
+				})
+			}
+		}
+		return requests
+	}
+}
+
+func belongsToNamespace(cr *v1alpha1.SearchAttribute, namespace *v1alpha1.TemporalNamespace) bool {
+	ref := cr.Spec.ForProvider.TemporalNamespaceNameRef
+	if ref != nil && ref.Name == namespace.GetName() {
+		return true
+	}
+
+	for _, ref := range cr.Spec.ForProvider.TemporalNamespaceNamesRefs {
+		if ref.Name == namespace.GetName() {
+			return true
+		}
+	}
+
+	names := cr.Spec.ForProvider.TemporalNamespaceNames
+	if cr.Spec.ForProvider.TemporalNamespaceName != nil {
+		names = append([]string{*cr.Spec.ForProvider.TemporalNamespaceName}, names...)
+	}
+
+	for _, name := range names {
+		if name == namespace.Spec.ForProvider.Name || name == meta.GetExternalName(namespace) {
+			return true
+		}
+	}
+	return false
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -98,9 +241,11 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube                   client.Client
 	usage                  resource.Tracker
+	record                 event.Recorder
 	logger                 logging.Logger
 	externalClientsByCreds syncmap.Map
 	newServiceFn           func(creds []byte) (temporal.SearchAttributeService, error)
+	newCloudServiceFn      func(creds []byte) (temporal.SearchAttributeService, error)
 }
 
 func hash(content []byte) string {
@@ -139,14 +284,21 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	credHash := hash(creds)
+	// Temporal Cloud does not serve the OperatorService, therefore its search
+	// attributes are managed via the Temporal Cloud Operations API
+	newServiceFn := c.newServiceFn
+	if pc.Spec.Mode == apisv1alpha1.ProviderModeCloud {
+		newServiceFn = c.newCloudServiceFn
+	}
+
+	credHash := hash(append([]byte(pc.Spec.Mode), creds...))
 
-	svc, err := c.newServiceFn(creds)
+	svc, err := newServiceFn(creds)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	ext := &external{service: svc, logger: c.logger, id: uuid.New().String()}
+	ext := &external{service: svc, cloud: pc.Spec.Mode == apisv1alpha1.ProviderModeCloud, record: c.record, logger: c.logger, id: uuid.New().String()}
 	value, ok := c.externalClientsByCreds.LoadOrStore(credHash, ext)
 	if ok {
 		ext.service.Close()
@@ -193,6 +345,8 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	service      temporal.SearchAttributeService
+	cloud        bool
+	record       event.Recorder
 	logger       logging.Logger
 	id           string
 	usageCounter int
@@ -231,18 +385,95 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotSearchAttribute)
 	}
 
+	if inMultipleNamespaces(cr) {
+		return c.observeMultipleNamespaces(ctx, cr)
+	}
+
 	externalName := meta.GetExternalName(cr)
 	c.logger.Debug("ExternalName: '" + externalName + "'")
 
+	lateInitialized, err := adoptExternalName(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
 	if cr.Spec.ForProvider.TemporalNamespaceName == nil {
 		return managed.ExternalObservation{}, errors.New("TemporalNamespaceName not set")
 	}
 
+	// Temporal Cloud can not remove search attributes, therefore they are kept
+	// when the managed resource is deleted
+	if c.cloud && meta.WasDeleted(cr) {
+		c.logger.Debug("Managed resource '" + cr.Name + "' is kept in Temporal Cloud")
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// A renamed SearchAttribute is renamed by the next update, as long as it
+	// exists with the name of its external name
+	if oldName := renamedFrom(cr); oldName != "" {
+		observed, err := c.service.DescribeSearchAttributeByName(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, oldName)
+		if err != nil && !temporal.IsNamespaceUnavailable(err) {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+		}
+
+		if observed != nil && observed.Origin != temporal.SearchAttributeOriginSystem {
+			c.logger.Debug("Managed resource '" + cr.Name + "' is renamed from '" + oldName + "' to '" + cr.Spec.ForProvider.Name + "'")
+			cr.Status.AtProvider = *observed
+			cr.SetConditions(xpv1.Available().WithMessage("SearchAttribute exists"))
+			return managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        false,
+				Diff:                    "name: " + oldName + " -> " + cr.Spec.ForProvider.Name,
+				ResourceLateInitialized: lateInitialized,
+				ConnectionDetails:       managed.ConnectionDetails{},
+			}, nil
+		}
+	}
+
 	observed, err := c.service.DescribeSearchAttributeByName(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, cr.Spec.ForProvider.Name)
+
+	// Search attributes are removed together with their namespace
+	if temporal.IsNamespaceUnavailable(err) && meta.WasDeleted(cr) {
+		c.logger.Debug("Namespace of managed resource '" + cr.Name + "' is already gone: " + err.Error())
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	// Nothing can be created until the namespace is registered, therefore the
+	// SearchAttribute waits for the next poll or the namespace to become ready
+	if temporal.IsNamespaceUnavailable(err) {
+		c.logger.Debug("Namespace of managed resource '" + cr.Name + "' is not registered: " + err.Error())
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errWaitingForNamespace, *cr.Spec.ForProvider.TemporalNamespaceName).Error())
+		condition.Reason = reasonWaitingForNamespace
+		cr.SetConditions(condition)
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
 	}
 
+	if observed == nil && isUnsupported(cr) {
+		c.logger.Debug("Managed resource '" + cr.Name + "' is not supported")
+		setUnsupported(cr)
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
 	if observed == nil {
 		c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
 		return managed.ExternalObservation{
@@ -256,8 +487,32 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	// Update Status
 	cr.Status.AtProvider = *observed
+
+	// System search attributes can neither be added nor removed
+	if observed.Origin == temporal.SearchAttributeOriginSystem {
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errSystemSearchAttribute, observed.Name).Error())
+		condition.Reason = reasonSystemSearchAttribute
+		cr.SetConditions(condition)
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
 	cr.SetConditions(xpv1.Available().WithMessage("SearchAttribute exists"))
 
+	if cr.Spec.ForProvider.Type == "" {
+		cr.Spec.ForProvider.Type = observed.Type
+		lateInitialized = true
+	}
+
+	// The SearchAttribute was already renamed, e.g. by a previous update, which
+	// failed to store the new external name
+	if renamedFrom(cr) != "" {
+		meta.SetExternalName(cr, observed.TemporalNamespaceName+"."+observed.Name)
+		lateInitialized = true
+	}
+
 	observedCompareable, err := c.service.MapToSearchAttributeCompare(observed)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
@@ -281,11 +536,72 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		ResourceExists:          true,
 		ResourceUpToDate:        resourceUpToDate,
 		Diff:                    diff,
-		ResourceLateInitialized: false,
+		ResourceLateInitialized: lateInitialized,
 		ConnectionDetails:       managed.ConnectionDetails{},
 	}, nil
 }
 
+// adoptExternalName initializes the namespace and the name of the
+// SearchAttribute with the external name <namespace>.<name>, which allows to
+// adopt an existing SearchAttribute. It returns true, if a field was
+// initialized.
+func adoptExternalName(cr *v1alpha1.SearchAttribute) (bool, error) {
+	externalName := meta.GetExternalName(cr)
+	if externalName == "" {
+		if cr.Spec.ForProvider.Name == "" {
+			return false, errors.New(errNameRequired)
+		}
+		return false, nil
+	}
+
+	namespace, name, err := splitExternalName(externalName)
+	if err != nil {
+		return false, err
+	}
+
+	initialized := false
+	if cr.Spec.ForProvider.TemporalNamespaceName == nil {
+		cr.Spec.ForProvider.TemporalNamespaceName = &namespace
+		initialized = true
+	} else if *cr.Spec.ForProvider.TemporalNamespaceName != namespace {
+		return false, errors.Errorf(errExternalNameMismatch, "temporalNamespaceName", *cr.Spec.ForProvider.TemporalNamespaceName, externalName)
+	}
+
+	if cr.Spec.ForProvider.Name == "" {
+		cr.Spec.ForProvider.Name = name
+		initialized = true
+	} else if cr.Spec.ForProvider.Name != name && !cr.Spec.ForProvider.AllowRename {
+		return false, errors.Errorf(errExternalNameMismatch, "name", cr.Spec.ForProvider.Name, externalName)
+	}
+
+	return initialized, nil
+}
+
+// splitExternalName splits the external name <namespace>.<name> into the
+// namespace and the name of the SearchAttribute.
+func splitExternalName(externalName string) (string, string, error) {
+	// Namespaces may contain dots, search attributes not
+	index := strings.LastIndex(externalName, ".")
+	if index <= 0 || index == len(externalName)-1 {
+		return "", "", errors.Errorf(errInvalidExternalName, externalName)
+	}
+	return externalName[:index], externalName[index+1:], nil
+}
+
+// renamedFrom returns the old name of a SearchAttribute, whose name was
+// changed with allowRename, or an empty string, if it was not renamed.
+func renamedFrom(cr *v1alpha1.SearchAttribute) string {
+	if !cr.Spec.ForProvider.AllowRename {
+		return ""
+	}
+
+	_, name, err := splitExternalName(meta.GetExternalName(cr))
+	if err != nil || name == cr.Spec.ForProvider.Name {
+		return ""
+	}
+	return name
+}
+
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	logger := c.logger.WithValues("method", "create", "serviceId", c.id)
 	logger.Debug("Start create")
@@ -294,8 +610,25 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotSearchAttribute)
 	}
 
+	if inMultipleNamespaces(cr) {
+		if err := c.updateMultipleNamespaces(ctx, cr); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+		}
+		return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, nil
+	}
+
+	if cr.Spec.ForProvider.Type == "" {
+		return managed.ExternalCreation{}, errors.New(errTypeRequired)
+	}
+
 	err := c.service.CreateSearchAttribute(ctx, &cr.Spec.ForProvider)
 
+	if temporal.IsUnsupportedError(err) {
+		cr.Status.AtProvider.UnsupportedReason = err.Error()
+		cr.Status.AtProvider.UnsupportedGeneration = cr.GetGeneration()
+		setUnsupported(cr)
+	}
+
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
@@ -318,7 +651,78 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotSearchAttribute)
 	}
 
-	return managed.ExternalUpdate{}, errors.New("Search Attribute '" + meta.GetExternalName(cr) + "' can not be updated! All properties are immutable!")
+	if inMultipleNamespaces(cr) {
+		if err := c.updateMultipleNamespaces(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+		return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}}, nil
+	}
+
+	if oldName := renamedFrom(cr); oldName != "" && cr.Status.AtProvider.Name == oldName {
+		return c.rename(ctx, cr, oldName)
+	}
+
+	if !cr.Spec.ForProvider.AllowRecreate {
+		return managed.ExternalUpdate{}, errors.Errorf(errImmutable, meta.GetExternalName(cr))
+	}
+
+	// Only the type can differ, because the name and namespace identify the
+	// SearchAttribute. It is recreated, because Temporal can not update it.
+	err := c.service.DeleteSearchAttributeByName(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRecreate)
+	}
+
+	err = c.service.CreateSearchAttribute(ctx, &cr.Spec.ForProvider)
+
+	if temporal.IsUnsupportedError(err) {
+		cr.Status.AtProvider.UnsupportedReason = err.Error()
+		cr.Status.AtProvider.UnsupportedGeneration = cr.GetGeneration()
+		setUnsupported(cr)
+	}
+
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRecreate)
+	}
+
+	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' recreated with type '" + cr.Spec.ForProvider.Type + "'")
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// rename removes the SearchAttribute with its old name and adds it with the
+// new name, because Temporal can not rename a SearchAttribute.
+func (c *external) rename(ctx context.Context, cr *v1alpha1.SearchAttribute, oldName string) (managed.ExternalUpdate, error) {
+	namespace := *cr.Spec.ForProvider.TemporalNamespaceName
+	err := c.service.DeleteSearchAttributeByName(ctx, namespace, oldName)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRename)
+	}
+
+	err = c.service.CreateSearchAttribute(ctx, &cr.Spec.ForProvider)
+
+	if temporal.IsUnsupportedError(err) {
+		cr.Status.AtProvider.UnsupportedReason = err.Error()
+		cr.Status.AtProvider.UnsupportedGeneration = cr.GetGeneration()
+		setUnsupported(cr)
+	}
+
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRename)
+	}
+
+	meta.SetExternalName(cr, namespace+"."+cr.Spec.ForProvider.Name)
+	c.record.Event(cr, event.Warning(reasonRenamed, errors.Errorf(errRenamed, oldName, cr.Spec.ForProvider.Name, oldName, cr.Spec.ForProvider.Name)))
+	c.logger.Debug("Managed resource '" + cr.Name + "' renamed from '" + oldName + "' to '" + cr.Spec.ForProvider.Name + "'")
+
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -329,7 +733,32 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotSearchAttribute)
 	}
 
-	err := c.service.DeleteSearchAttributeByName(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, cr.Spec.ForProvider.Name)
+	if inMultipleNamespaces(cr) {
+		return errors.Wrap(c.deleteMultipleNamespaces(ctx, cr), errDelete)
+	}
+
+	if isUnsupported(cr) {
+		c.logger.Debug("Managed resource '" + cr.Name + "' was not created, because it is not supported")
+		return nil
+	}
+
+	if cr.Status.AtProvider.Origin == temporal.SearchAttributeOriginSystem {
+		c.logger.Debug("Managed resource '" + cr.Name + "' is a system SearchAttribute and is not deleted")
+		return nil
+	}
+
+	// A SearchAttribute, which was not renamed yet, exists with its old name
+	name := cr.Spec.ForProvider.Name
+	if oldName := renamedFrom(cr); oldName != "" && cr.Status.AtProvider.Name == oldName {
+		name = oldName
+	}
+
+	err := c.service.DeleteSearchAttributeByName(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, name)
+
+	if temporal.IsNamespaceUnavailable(err) {
+		c.logger.Debug("Namespace of managed resource '" + cr.Name + "' is already gone: " + err.Error())
+		return nil
+	}
 
 	if err != nil {
 		return errors.Wrap(err, errDelete)
@@ -338,3 +767,26 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	c.logger.Debug("Managed resource '" + meta.GetExternalName(cr) + "' deleted")
 	return nil
 }
+
+// isUnsupported returns true, if the server rejected the current generation of
+// the SearchAttribute, because it does not support it.
+func isUnsupported(cr *v1alpha1.SearchAttribute) bool {
+	return cr.Status.AtProvider.UnsupportedReason != "" && cr.Status.AtProvider.UnsupportedGeneration == cr.GetGeneration()
+}
+
+// setUnsupported sets a condition with the reason, why the server rejected the
+// SearchAttribute. A reached limit of custom SearchAttributes gets its own
+// reason, because it is resolved by removing another SearchAttribute.
+func setUnsupported(cr *v1alpha1.SearchAttribute) {
+	reason := cr.Status.AtProvider.UnsupportedReason
+	if limit, ok := temporal.ParseSearchAttributeLimit(reason); ok {
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errLimitReached, limit.Limit, limit.Type, *cr.Spec.ForProvider.TemporalNamespaceName, reason).Error())
+		condition.Reason = reasonLimitReached
+		cr.SetConditions(condition)
+		return
+	}
+
+	condition := xpv1.Unavailable().WithMessage(errors.Errorf(errUnsupported, reason).Error())
+	condition.Reason = reasonUnsupported
+	cr.SetConditions(condition)
+}
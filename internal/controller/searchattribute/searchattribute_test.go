@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package searchattribute
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+// fakeOperatorService counts the requests to list the search attributes.
+type fakeOperatorService struct {
+	operatorservice.UnimplementedOperatorServiceServer
+	lists atomic.Int32
+}
+
+func (s *fakeOperatorService) ListSearchAttributes(_ context.Context, _ *operatorservice.ListSearchAttributesRequest) (*operatorservice.ListSearchAttributesResponse, error) {
+	s.lists.Add(1)
+	return &operatorservice.ListSearchAttributesResponse{
+		CustomAttributes: map[string]enums.IndexedValueType{"test1": enums.INDEXED_VALUE_TYPE_KEYWORD},
+	}, nil
+}
+
+// startOperatorService serves the fake OperatorService on a local port. The
+// WorkflowService is not served, which the Temporal client tolerates.
+func startOperatorService(t *testing.T) (*fakeOperatorService, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &fakeOperatorService{}
+	server := grpc.NewServer()
+	operatorservice.RegisterOperatorServiceServer(server, service)
+	go server.Serve(listener) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	return service, listener.Addr().String()
+}
+
+func TestSearchAttributesAreSharedAcrossConnections(t *testing.T) {
+	service, hostPort := startOperatorService(t)
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	pc.SetName("test")
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+	pc.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "test", Namespace: "crossplane-system"},
+		Key:             "credentials",
+	}
+
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *apisv1alpha1.ProviderConfig:
+				pc.DeepCopyInto(o)
+			case *corev1.Secret:
+				o.Data = map[string][]byte{"credentials": []byte(`{"hostPort": "` + hostPort + `"}`)}
+			}
+			return nil
+		},
+	}
+
+	c := &connector{
+		kube:         kube,
+		usage:        resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+		logger:       logging.NewNopLogger(),
+		newServiceFn: temporal.NewSearchAttributeService,
+	}
+
+	namespace := "default"
+	cr := &v1alpha1.SearchAttribute{}
+	cr.SetName("test1")
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "test"})
+	cr.Spec.ForProvider.Name = "test1"
+	cr.Spec.ForProvider.Type = "Keyword"
+	cr.Spec.ForProvider.TemporalNamespaceName = &namespace
+
+	// Each reconcile connects, observes and disconnects, which closes the
+	// Temporal client in between
+	for i := 0; i < 2; i++ {
+		ext, err := c.Connect(context.Background(), cr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		observation, err := ext.Observe(context.Background(), cr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !observation.ResourceExists {
+			t.Fatalf("Expected SearchAttribute test1 to exist")
+		}
+
+		if err := c.Disconnect(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if lists := service.lists.Load(); lists != 1 {
+		t.Fatalf("Expected 1 list call, got %d", lists)
+	}
+}
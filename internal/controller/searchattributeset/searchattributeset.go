@@ -0,0 +1,471 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package searchattributeset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/syncmap"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/backoff"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+	"github.com/denniskniep/provider-temporal/internal/drift"
+	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/pollinterval"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+	"github.com/denniskniep/provider-temporal/internal/requestinfo"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	"github.com/denniskniep/provider-temporal/internal/usage"
+)
+
+const (
+	errNotSearchAttributeSet = "managed resource is not a SearchAttributeSet custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errGetPC                 = "cannot get ProviderConfig"
+	errGetCreds              = "cannot get credentials"
+	errDescribe              = "failed to describe SearchAttributeSet resource"
+	errNewClient             = "cannot create new Service"
+	errAdd                   = "failed to add SearchAttributes of SearchAttributeSet resource"
+	errRemove                = "failed to remove SearchAttributes of SearchAttributeSet resource"
+	errConflicting           = "SearchAttributes %s exist with another type, which can not be changed"
+	errWaitingForNamespace   = "waiting for namespace %q to be registered"
+
+	// reasonWaitingForNamespace indicates that the SearchAttributes are not
+	// created yet, because their namespace does not exist or is not registered.
+	reasonWaitingForNamespace xpv1.ConditionReason = "WaitingForNamespace"
+
+	// reasonConflicting indicates that SearchAttributes of the set already
+	// exist with another type.
+	reasonConflicting xpv1.ConditionReason = "Conflicting"
+)
+
+// Setup adds a controller that reconciles SearchAttributeSet managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	o.Logger.Info("Setup Controller: SearchAttributeSet")
+	name := managed.ControllerName(v1alpha1.SearchAttributeSetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	exhausted := backoff.NewTracker()
+	terminated := terminal.NewTracker()
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnectDisconnecter(exhausted.NewConnector(terminated.NewConnector(timeout.NewConnector(requestinfo.NewConnector(drift.NewConnector(recorder, &connector{
+			externalClientsByCreds: syncmap.Map{},
+			kube:                   mgr.GetClient(),
+			usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:           temporal.NewSearchAttributeSetService,
+			logger:                 o.Logger.WithValues("controller", name)})))))),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		pollinterval.WithHook(),
+		timeout.WithSyncTimeout(),
+		managed.WithRecorder(recorder),
+		managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient())),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.SearchAttributeSetGroupVersionKind), opts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(ratelimit.ForControllerRuntime(o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.SearchAttributeSet{}).
+		Complete(ratelimiter.NewReconciler(name, exhausted.NewReconciler(terminated.NewReconciler(r, o.PollInterval)), o.GlobalRateLimiter))
+}
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube                   client.Client
+	usage                  resource.Tracker
+	logger                 logging.Logger
+	externalClientsByCreds syncmap.Map
+	newServiceFn           func(creds []byte) (temporal.SearchAttributeSetService, error)
+}
+
+func hash(content []byte) string {
+	h := sha256.New()
+	h.Write(content)
+	sha := h.Sum(nil)
+	shaStr := hex.EncodeToString(sha)
+	return shaStr
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	logger := c.logger.WithValues("method", "connect")
+	logger.Debug("Start Connect")
+	cr, ok := mg.(*v1alpha1.SearchAttributeSet)
+	if !ok {
+		return nil, errors.New(errNotSearchAttributeSet)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	creds, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	credHash := hash(creds)
+
+	svc, err := c.newServiceFn(creds)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	ext := &external{service: svc, logger: c.logger, id: uuid.New().String()}
+	value, ok := c.externalClientsByCreds.LoadOrStore(credHash, ext)
+	if ok {
+		ext.service.Close()
+		ext = value.(*external)
+		logger.Debug("Use existing " + ext.id)
+	} else {
+		logger.Debug("Connected " + ext.id)
+	}
+
+	ext.IncrementUsageCounter()
+	return ext, nil
+}
+
+func (c *connector) Disconnect(ctx context.Context) error {
+	logger := c.logger.WithValues("method", "disconnect")
+	logger.Debug("Start Disconnect")
+
+	c.externalClientsByCreds.Range(func(key, value interface{}) bool {
+
+		ext := value.(*external)
+		ext.DecrementUsageCounter()
+		if ext.GetUsageCounter() < 0 {
+			ext.SetUsageCounter(0)
+		}
+
+		if ext.GetUsageCounter() == 0 && ext.service != nil {
+			ext.service.Close()
+			c.externalClientsByCreds.LoadAndDelete(key)
+			logger.Debug("Disconnected " + ext.id)
+		} else {
+			logger.Debug("Keep connection " + ext.id)
+		}
+
+		// this will continue iterating
+		return true
+	})
+
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	// A 'client' used to connect to the external resource API. In practice this
+	// would be something like an AWS SDK client.
+	service      temporal.SearchAttributeSetService
+	logger       logging.Logger
+	id           string
+	usageCounter int
+	sync.RWMutex
+}
+
+func (c *external) GetUsageCounter() int {
+	c.RLock()
+	defer c.RUnlock()
+	return c.usageCounter
+}
+
+func (c *external) IncrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter++
+}
+
+func (c *external) DecrementUsageCounter() {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter--
+}
+
+func (c *external) SetUsageCounter(usageCounter int) {
+	c.Lock()
+	defer c.Unlock()
+	c.usageCounter = usageCounter
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	logger := c.logger.WithValues("method", "observe", "serviceId", c.id)
+	logger.Debug("Start observe")
+	cr, ok := mg.(*v1alpha1.SearchAttributeSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSearchAttributeSet)
+	}
+
+	externalName := meta.GetExternalName(cr)
+	c.logger.Debug("ExternalName: '" + externalName + "'")
+
+	if cr.Spec.ForProvider.TemporalNamespaceName == nil {
+		return managed.ExternalObservation{}, errors.New("TemporalNamespaceName not set")
+	}
+	namespace := *cr.Spec.ForProvider.TemporalNamespaceName
+
+	// All SearchAttributes of the namespace are listed with a single request
+	observed, err := c.service.DescribeSearchAttributesByNamespace(ctx, namespace)
+
+	// Search attributes are removed together with their namespace
+	if temporal.IsNamespaceUnavailable(err) && meta.WasDeleted(cr) {
+		c.logger.Debug("Namespace of managed resource '" + cr.Name + "' is already gone: " + err.Error())
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	if temporal.IsNamespaceUnavailable(err) {
+		c.logger.Debug("Namespace of managed resource '" + cr.Name + "' is not registered: " + err.Error())
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errWaitingForNamespace, namespace).Error())
+		condition.Reason = reasonWaitingForNamespace
+		cr.SetConditions(condition)
+		return managed.ExternalObservation{
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	ownedSearchAttributes := ownedSearchAttributes(cr, observed)
+	cr.Status.AtProvider = v1alpha1.SearchAttributeSetObservation{
+		TemporalNamespaceName: namespace,
+		SearchAttributes:      ownedSearchAttributes,
+	}
+
+	if meta.WasDeleted(cr) || externalName == "" {
+		if len(ownedSearchAttributes) == 0 {
+			c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
+			return managed.ExternalObservation{
+				ResourceExists:    false,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}, nil
+		}
+	}
+
+	diff := temporal.DiffSearchAttributeSet(cr.Spec.ForProvider.SearchAttributes, cr.Status.ManagedSearchAttributes, observed)
+	if len(diff.Conflicting) > 0 {
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errConflicting, strings.Join(diff.Conflicting, ", ")).Error())
+		condition.Reason = reasonConflicting
+		cr.SetConditions(condition)
+	} else {
+		cr.SetConditions(xpv1.Available().WithMessage("SearchAttributeSet exists"))
+	}
+
+	// Conflicting SearchAttributes can not be updated, therefore they are
+	// only reported by the condition
+	resourceUpToDate := len(diff.Missing) == 0 && len(diff.Removed) == 0
+
+	diffText := ""
+	if !resourceUpToDate {
+		diffText = cmp.Diff(cr.Spec.ForProvider.SearchAttributes, ownedSearchAttributes)
+	}
+	c.logger.Debug("Managed resource '" + cr.Name + "' upToDate: " + strconv.FormatBool(resourceUpToDate) + "")
+
+	// Removed SearchAttributes are kept as managed until they are removed
+	if resourceUpToDate {
+		cr.Status.ManagedSearchAttributes = searchAttributeNames(cr.Spec.ForProvider.SearchAttributes)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        resourceUpToDate,
+		Diff:                    diffText,
+		ResourceLateInitialized: false,
+		ConnectionDetails:       managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	logger := c.logger.WithValues("method", "create", "serviceId", c.id)
+	logger.Debug("Start create")
+	cr, ok := mg.(*v1alpha1.SearchAttributeSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSearchAttributeSet)
+	}
+
+	if len(cr.Spec.ForProvider.SearchAttributes) > 0 {
+		err := c.service.AddSearchAttributes(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, cr.Spec.ForProvider.SearchAttributes)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errAdd)
+		}
+	}
+
+	meta.SetExternalName(cr, *cr.Spec.ForProvider.TemporalNamespaceName)
+	c.logger.Debug("Managed resource '" + cr.Name + "' created")
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	logger := c.logger.WithValues("method", "update", "serviceId", c.id)
+	logger.Debug("Start update")
+	cr, ok := mg.(*v1alpha1.SearchAttributeSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSearchAttributeSet)
+	}
+
+	namespace := *cr.Spec.ForProvider.TemporalNamespaceName
+	observed, err := c.service.DescribeSearchAttributesByNamespace(ctx, namespace)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	// Each drift is reconciled with a single request
+	diff := temporal.DiffSearchAttributeSet(cr.Spec.ForProvider.SearchAttributes, cr.Status.ManagedSearchAttributes, observed)
+	if len(diff.Removed) > 0 {
+		if err := c.service.RemoveSearchAttributes(ctx, namespace, diff.Removed); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemove)
+		}
+	}
+
+	if len(diff.Missing) > 0 {
+		if err := c.service.AddSearchAttributes(ctx, namespace, diff.Missing); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAdd)
+		}
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		meta.SetExternalName(cr, namespace)
+	}
+
+	c.logger.Debug("Managed resource '" + cr.Name + "' updated")
+	return managed.ExternalUpdate{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	logger := c.logger.WithValues("method", "delete", "serviceId", c.id)
+	logger.Debug("Start delete")
+	cr, ok := mg.(*v1alpha1.SearchAttributeSet)
+	if !ok {
+		return errors.New(errNotSearchAttributeSet)
+	}
+
+	names := searchAttributeNames(cr.Status.AtProvider.SearchAttributes)
+	if len(names) == 0 {
+		return nil
+	}
+
+	err := c.service.RemoveSearchAttributes(ctx, *cr.Spec.ForProvider.TemporalNamespaceName, names)
+
+	if temporal.IsNamespaceUnavailable(err) {
+		c.logger.Debug("Namespace of managed resource '" + cr.Name + "' is already gone: " + err.Error())
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, errRemove)
+	}
+
+	c.logger.Debug("Managed resource '" + cr.Name + "' deleted")
+	return nil
+}
+
+// ownedSearchAttributes returns the observed SearchAttributes, which are part
+// of the spec with the same type or were managed by the set before.
+// SearchAttributes, which exist with another type, are not owned and
+// therefore never removed by the set.
+func ownedSearchAttributes(cr *v1alpha1.SearchAttributeSet, observed map[string]string) map[string]string {
+	owned := map[string]string{}
+	for name, attrType := range observed {
+		if specType, ok := cr.Spec.ForProvider.SearchAttributes[name]; ok && specType == attrType {
+			owned[name] = attrType
+		}
+	}
+
+	for _, name := range cr.Status.ManagedSearchAttributes {
+		if attrType, ok := observed[name]; ok {
+			owned[name] = attrType
+		}
+	}
+	return owned
+}
+
+// searchAttributeNames returns the sorted names of the SearchAttributes
+func searchAttributeNames(searchAttributes map[string]string) []string {
+	names := make([]string, 0, len(searchAttributes))
+	for name := range searchAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -20,9 +20,25 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudapikey"
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudmetricsendpoint"
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudnamespace"
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudnamespaceaccess"
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudnamespaceexportsink"
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudnexusendpoint"
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudserviceaccount"
+	"github.com/denniskniep/provider-temporal/internal/controller/clouduser"
+	"github.com/denniskniep/provider-temporal/internal/controller/cloudusergroup"
 	"github.com/denniskniep/provider-temporal/internal/controller/config"
+	"github.com/denniskniep/provider-temporal/internal/controller/namespacefailover"
+	"github.com/denniskniep/provider-temporal/internal/controller/remotecluster"
+	"github.com/denniskniep/provider-temporal/internal/controller/schedule"
 	"github.com/denniskniep/provider-temporal/internal/controller/searchattribute"
+	"github.com/denniskniep/provider-temporal/internal/controller/searchattributeset"
+	"github.com/denniskniep/provider-temporal/internal/controller/taskqueue"
 	"github.com/denniskniep/provider-temporal/internal/controller/temporalnamespace"
+	"github.com/denniskniep/provider-temporal/internal/controller/workerbuildidcompatibility"
+	"github.com/denniskniep/provider-temporal/internal/usage"
 )
 
 // Setup creates all temporal controllers with the supplied logger and adds them to
@@ -30,8 +46,26 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		config.Setup,
+		config.SetupConnectionTest,
+		config.SetupOrphanedNamespaceReport,
+		usage.SetupGarbageCollector,
 		temporalnamespace.Setup,
 		searchattribute.Setup,
+		searchattributeset.Setup,
+		schedule.Setup,
+		workerbuildidcompatibility.Setup,
+		taskqueue.Setup,
+		remotecluster.Setup,
+		namespacefailover.Setup,
+		cloudnamespace.Setup,
+		clouduser.Setup,
+		cloudusergroup.Setup,
+		cloudserviceaccount.Setup,
+		cloudapikey.Setup,
+		cloudnamespaceaccess.Setup,
+		cloudnamespaceexportsink.Setup,
+		cloudmetricsendpoint.Setup,
+		cloudnexusendpoint.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
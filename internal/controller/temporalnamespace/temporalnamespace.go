@@ -4,15 +4,21 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"go.temporal.io/api/serviceerror"
 	"golang.org/x/sync/syncmap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,8 +33,18 @@ import (
 
 	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
 	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	"github.com/denniskniep/provider-temporal/internal/asyncoperation"
+	"github.com/denniskniep/provider-temporal/internal/backoff"
 	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+	"github.com/denniskniep/provider-temporal/internal/drift"
 	"github.com/denniskniep/provider-temporal/internal/features"
+	"github.com/denniskniep/provider-temporal/internal/pollinterval"
+	"github.com/denniskniep/provider-temporal/internal/providerref"
+	"github.com/denniskniep/provider-temporal/internal/ratelimit"
+	"github.com/denniskniep/provider-temporal/internal/requestinfo"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+	"github.com/denniskniep/provider-temporal/internal/usage"
 )
 
 const (
@@ -36,6 +52,55 @@ const (
 	errTrackPCUsage         = "cannot track ProviderConfig usage"
 	errGetPC                = "cannot get ProviderConfig"
 	errGetCreds             = "cannot get credentials"
+	errNamePattern          = "cannot compile allowedNamespaceNamePattern of ProviderConfig"
+	errNameNotAllowed       = "namespace name %q does not match allowedNamespaceNamePattern %q of ProviderConfig %q"
+	errListNamespaces       = "cannot list TemporalNamespaces"
+	errQuotaExceeded        = "maxNamespaces %d of ProviderConfig %q is exceeded"
+	errArchivalUriImmutable = "%s can not be changed once set"
+	errNameRequired         = "name or external name is required"
+	errNameMismatch         = "name %q does not match external name %q"
+	errNameReserved         = "name %q is reserved by Temporal"
+	errRetentionRejected    = "workflowExecutionRetention %s was rejected by the server"
+	errAlreadyExists        = "namespace %q already exists and is not adopted, because of adoptionPolicy Strict"
+	errGetDataFromSecret    = "cannot get secret of dataFrom key %q"
+	errRecoverCreate        = "cannot recover incomplete create of namespace"
+	errGetDataFromConfigMap = "cannot get configmap of dataFrom key %q"
+	errDataFromKeyNotFound  = "key %q of dataFrom key %q not found"
+	errListDependents       = "cannot list resources, which depend on the namespace"
+	errInUse                = "namespace %q is not deleted, because it is still used by %s"
+
+	// reservedNamespaceName is the namespace of Temporal itself, which must
+	// not be managed by a TemporalNamespace.
+	reservedNamespaceName = "temporal-system"
+
+	// Keys of the published connection details.
+	connectionDetailHostPort  = "hostPort"
+	connectionDetailNamespace = "namespace"
+	connectionDetailCACertPem = "caCertPem"
+	connectionDetailCertPem   = "certPem"
+	connectionDetailKeyPem    = "keyPem"
+
+	// reasonQuotaExceeded indicates that a TemporalNamespace was not created,
+	// because the maxNamespaces of its ProviderConfig are exceeded.
+	reasonQuotaExceeded xpv1.ConditionReason = "QuotaExceeded"
+
+	// reasonArchivalUriImmutable indicates that the archival URIs of a
+	// TemporalNamespace can not be updated, because Temporal does not allow
+	// to change them once set.
+	reasonArchivalUriImmutable xpv1.ConditionReason = "ArchivalUriImmutable"
+
+	// reasonAlreadyExists indicates that a TemporalNamespace with
+	// adoptionPolicy Strict does not manage a namespace, because it was not
+	// created by the TemporalNamespace.
+	reasonAlreadyExists xpv1.ConditionReason = "AlreadyExists"
+
+	// reasonInUse indicates that a TemporalNamespace is not deleted, because
+	// SearchAttributes or SearchAttributeSets still depend on it.
+	reasonInUse xpv1.ConditionReason = "InUse"
+
+	// reasonRetentionRejected indicates that the server rejected the retention
+	// of a TemporalNamespace. The other fields are still reconciled.
+	reasonRetentionRejected xpv1.ConditionReason = "RetentionRejected"
 
 	errNewClient = "cannot create new Service"
 	errDescribe  = "failed to describe Namespace resource"
@@ -55,26 +120,39 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
-	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.TemporalNamespaceGroupVersionKind),
-		managed.WithExternalConnectDisconnecter(&connector{
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	exhausted := backoff.NewTracker()
+	terminated := terminal.NewTracker()
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnectDisconnecter(exhausted.NewConnector(terminated.NewConnector(timeout.NewConnector(requestinfo.NewConnector(drift.NewConnector(recorder, &connector{
 			externalClientsByCreds: syncmap.Map{},
 			kube:                   mgr.GetClient(),
 			usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			quota:                  &quota{kube: mgr.GetClient()},
 			newServiceFn:           temporal.NewNamespaceService,
-			logger:                 o.Logger.WithValues("controller", name)}),
+			logger:                 o.Logger.WithValues("controller", name)})))))),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithInitializers(),
-		managed.WithConnectionPublishers(cps...))
+		pollinterval.WithHook(),
+		timeout.WithSyncTimeout(),
+		managed.WithRecorder(recorder),
+		managed.WithInitializers(providerref.NewInitializer(mgr.GetClient()), newCreateRecoverer(mgr.GetClient())),
+		managed.WithFinalizer(usage.NewFinalizer(mgr.GetClient())),
+		managed.WithConnectionPublishers(cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.TemporalNamespaceGroupVersionKind), opts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(ratelimit.ForControllerRuntime(o)).
 		WithEventFilter(resource.DesiredStateChanged()).
 		For(&v1alpha1.TemporalNamespace{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		Complete(ratelimiter.NewReconciler(name, exhausted.NewReconciler(terminated.NewReconciler(r, o.PollInterval)), o.GlobalRateLimiter))
 }
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -82,6 +160,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube                   client.Client
 	usage                  resource.Tracker
+	quota                  *quota
 	logger                 logging.Logger
 	externalClientsByCreds syncmap.Map
 	newServiceFn           func(creds []byte) (temporal.NamespaceService, error)
@@ -95,6 +174,147 @@ func hash(content []byte) string {
 	return shaStr
 }
 
+// A policyError rejects a TemporalNamespace, which violates the naming policy
+// of its ProviderConfig. It is terminal, so that the TemporalNamespace is not
+// retried with backoff, because it does not comply unchanged.
+type policyError struct {
+	error
+}
+
+// Terminal returns always true.
+func (e *policyError) Terminal() bool {
+	return true
+}
+
+// validateName rejects TemporalNamespaces whose name does not match the naming
+// policy of their ProviderConfig. Resources which are being deleted are not
+// validated, so that they can always be cleaned up.
+func validateName(pc *apisv1alpha1.ProviderConfig, cr *v1alpha1.TemporalNamespace) error {
+	if pc.Spec.AllowedNamespaceNamePattern == nil || meta.WasDeleted(cr) {
+		return nil
+	}
+
+	pattern, err := regexp.Compile("^(?:" + *pc.Spec.AllowedNamespaceNamePattern + ")$")
+	if err != nil {
+		return &policyError{errors.Wrap(err, errNamePattern)}
+	}
+
+	name := cr.Spec.ForProvider.Name
+	if name == "" {
+		name = meta.GetExternalName(cr)
+	}
+
+	if !pattern.MatchString(name) {
+		return &policyError{errors.Errorf(errNameNotAllowed, name, *pc.Spec.AllowedNamespaceNamePattern, pc.Name)}
+	}
+	return nil
+}
+
+// A quotaError rejects a TemporalNamespace, which would exceed the maximum
+// number of namespaces of its ProviderConfig. It is terminal, so that the
+// TemporalNamespace is checked again on the next poll instead of being retried
+// with backoff, and keeps its QuotaExceeded condition.
+type quotaError struct {
+	error
+}
+
+// Terminal returns always true.
+func (e *quotaError) Terminal() bool {
+	return true
+}
+
+// Reason returns the reason of the Ready condition of the TemporalNamespace.
+func (e *quotaError) Reason() xpv1.ConditionReason {
+	return reasonQuotaExceeded
+}
+
+// A quota limits the number of TemporalNamespaces, which are created or
+// adopted through a ProviderConfig. Its checks are serialised per
+// ProviderConfig, so that concurrent reconciles can not exceed the limit.
+type quota struct {
+	kube client.Client
+
+	// locks are the mutexes of the checks by ProviderConfig name.
+	locks syncmap.Map
+
+	// granted are the ProviderConfig names by uid of the TemporalNamespaces,
+	// which passed a check. They are counted, even if their external name is
+	// not yet persisted.
+	granted syncmap.Map
+}
+
+func (q *quota) lock(name string) func() {
+	value, _ := q.locks.LoadOrStore(name, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// check rejects a TemporalNamespace, which is about to be created or adopted,
+// if its ProviderConfig already manages its maximum number of namespaces.
+// Other TemporalNamespaces count once their external name is set or once they
+// passed a check.
+func (q *quota) check(ctx context.Context, cr *v1alpha1.TemporalNamespace) error {
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := q.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return errors.Wrap(err, errGetPC)
+	}
+
+	if pc.Spec.MaxNamespaces == nil {
+		return nil
+	}
+
+	defer q.lock(pc.Name)()
+
+	l := &v1alpha1.TemporalNamespaceList{}
+	if err := q.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListNamespaces)
+	}
+
+	count := 0
+	listed := map[types.UID]bool{}
+	for i := range l.Items {
+		ns := &l.Items[i]
+		if ns.GetProviderConfigReference() == nil || ns.GetProviderConfigReference().Name != pc.Name {
+			continue
+		}
+
+		listed[ns.GetUID()] = true
+		if ns.GetUID() == cr.GetUID() {
+			continue
+		}
+
+		if _, granted := q.granted.Load(ns.GetUID()); granted || meta.GetExternalName(ns) != "" {
+			count++
+		}
+	}
+
+	// TemporalNamespaces, which were deleted in the meantime, are released
+	q.granted.Range(func(uid, name interface{}) bool {
+		if name == pc.Name && !listed[uid.(types.UID)] {
+			q.granted.Delete(uid)
+		}
+		return true
+	})
+
+	if count >= *pc.Spec.MaxNamespaces {
+		err := errors.Errorf(errQuotaExceeded, *pc.Spec.MaxNamespaces, pc.Name)
+		condition := xpv1.Unavailable().WithMessage(err.Error())
+		condition.Reason = reasonQuotaExceeded
+		cr.SetConditions(condition)
+		return &quotaError{err}
+	}
+
+	q.granted.Store(cr.GetUID(), pc.Name)
+	return nil
+}
+
+// adopts returns true, if an existing namespace is about to be adopted by the
+// TemporalNamespace, i.e. it was neither observed nor created before.
+func adopts(cr *v1alpha1.TemporalNamespace) bool {
+	return cr.Status.AtProvider.Id == "" && meta.GetExternalCreateSucceeded(cr).IsZero()
+}
+
 // Connect typically produces an ExternalClient by:
 // 1. Tracking that the managed resource is using a ProviderConfig.
 // 2. Getting the managed resource's ProviderConfig.
@@ -117,6 +337,10 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetPC)
 	}
 
+	if err := validateName(pc, cr); err != nil {
+		return nil, err
+	}
+
 	cd := pc.Spec.Credentials
 	creds, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
 	if err != nil {
@@ -129,7 +353,7 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	ext := &external{service: svc, logger: c.logger, id: uuid.New().String()}
+	ext := &external{service: svc, kube: c.kube, quota: c.quota, logger: c.logger, id: uuid.New().String()}
 	value, ok := c.externalClientsByCreds.LoadOrStore(credHash, ext)
 	if ok {
 		ext.service.Close()
@@ -176,6 +400,8 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
 	service      temporal.NamespaceService
+	kube         client.Client
+	quota        *quota
 	logger       logging.Logger
 	id           string
 	usageCounter int
@@ -206,6 +432,25 @@ func (c *external) SetUsageCounter(usageCounter int) {
 	c.usageCounter = usageCounter
 }
 
+// connectionDetails returns the details, which are required by workers and
+// clients to connect to the namespace. They are published to the connection
+// secret or, if enabled, to an External Secret Store, once the namespace is
+// registered.
+func (c *external) connectionDetails(cr *v1alpha1.TemporalNamespace) managed.ConnectionDetails {
+	details := managed.ConnectionDetails{
+		connectionDetailHostPort:  []byte(c.service.HostPort()),
+		connectionDetailNamespace: []byte(cr.Spec.ForProvider.Name),
+	}
+
+	certificates := c.service.TLSCertificates()
+	if cr.Spec.ForProvider.PublishTLSConnectionDetails && certificates != nil {
+		details[connectionDetailCACertPem] = []byte(certificates.CACertPem)
+		details[connectionDetailCertPem] = []byte(certificates.CertPem)
+		details[connectionDetailKeyPem] = []byte(certificates.KeyPem)
+	}
+	return details
+}
+
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	logger := c.logger.WithValues("method", "observe", "serviceId", c.id)
 	logger.Debug("Start observe")
@@ -217,6 +462,15 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	externalName := meta.GetExternalName(cr)
 	c.logger.Debug("ExternalName: '" + externalName + "'")
 
+	nameLateInitialized, err := adoptExternalName(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if meta.WasDeleted(cr) {
+		return c.observeDeletion(ctx, cr)
+	}
+
 	observed, err := c.service.DescribeNamespaceByName(ctx, cr.Spec.ForProvider.Name)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
@@ -224,6 +478,19 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	if observed == nil {
 		c.logger.Debug("Managed resource '" + cr.Name + "' does not exist")
+
+		if err := c.quota.check(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+
+		// The external name is recorded before the namespace is registered,
+		// so that the reconciler persists it together with the create pending
+		// annotation. Therefore the namespace is still known as created by
+		// the TemporalNamespace, if the provider crashes during the create.
+		if meta.GetExternalName(cr) == "" {
+			meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+		}
+
 		return managed.ExternalObservation{
 			ResourceExists:    false,
 			ResourceUpToDate:  false,
@@ -233,11 +500,29 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	c.logger.Debug("Found '" + observed.Name + "' with id '" + observed.Id + "'")
 
+	if refuseAdoption(cr) {
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errAlreadyExists, observed.Name).Error())
+		condition.Reason = reasonAlreadyExists
+		cr.SetConditions(condition)
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	if adopts(cr) {
+		if err := c.quota.check(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	// Update Status
 	cr.Status.AtProvider = *observed
 
+	connectionDetails := managed.ConnectionDetails{}
 	if observed.State == "Registered" {
 		cr.SetConditions(xpv1.Available().WithMessage("Namespace.State = " + observed.State))
+		connectionDetails = c.connectionDetails(cr)
 	}
 
 	if observed.State == "Unspecified" {
@@ -248,16 +533,46 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.SetConditions(xpv1.Deleting().WithMessage("Namespace.State = " + observed.State))
 	}
 
+	lateInitialized := lateInitialize(&cr.Spec.ForProvider, observed) || nameLateInitialized
+
 	observedCompareable, err := c.service.MapToNamespaceCompare(observed)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
 	}
 
-	specCompareable, err := c.service.MapToNamespaceCompare(&cr.Spec.ForProvider)
+	namespace, err := c.resolveParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	specCompareable, err := c.service.MapToNamespaceCompare(namespace)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errMapping)
 	}
 
+	temporal.IgnoreUnmanagedConfig(specCompareable, observedCompareable)
+
+	if cr.Spec.ForProvider.DataMergeStrategy == v1alpha1.DataMergeStrategyMerge {
+		temporal.IgnoreUnmanagedDataKeys(specCompareable, observedCompareable, cr.Status.ManagedDataKeys)
+	} else {
+		temporal.IgnoreClearedDataKeys(specCompareable, observedCompareable)
+	}
+
+	if retentionRejected(cr, specCompareable) {
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errRetentionRejected, specCompareable.WorkflowExecutionRetention.Duration).Error())
+		condition.Reason = reasonRetentionRejected
+		cr.SetConditions(condition)
+		specCompareable.WorkflowExecutionRetention = observedCompareable.WorkflowExecutionRetention
+	} else {
+		cr.Status.RejectedWorkflowExecutionRetention = nil
+	}
+
+	if ignored := temporal.IgnoreImmutableArchivalUris(specCompareable, observedCompareable); len(ignored) > 0 {
+		condition := xpv1.Unavailable().WithMessage(errors.Errorf(errArchivalUriImmutable, strings.Join(ignored, ", ")).Error())
+		condition.Reason = reasonArchivalUriImmutable
+		cr.SetConditions(condition)
+	}
+
 	diff := ""
 	resourceUpToDate := cmp.Equal(specCompareable, observedCompareable)
 
@@ -267,12 +582,265 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	c.logger.Debug("Managed resource '" + cr.Name + "' upToDate: " + strconv.FormatBool(resourceUpToDate) + "")
 
+	// Removed keys are kept as managed until they are cleared by an update
+	if resourceUpToDate {
+		cr.Status.ManagedDataKeys = dataKeys(namespace.Data)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceUpToDate:        resourceUpToDate,
 		Diff:                    diff,
-		ResourceLateInitialized: false,
-		ConnectionDetails:       managed.ConnectionDetails{},
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       connectionDetails,
+	}, nil
+}
+
+// newCreateRecoverer returns an initializer, which recovers a TemporalNamespace,
+// whose create is incomplete, because the provider crashed after it started
+// to register the namespace. Its external name was recorded before, therefore
+// it is safe to mark the create as failed: the next observe either adopts
+// the registered namespace by its name or registers it again.
+func newCreateRecoverer(kube client.Client) managed.Initializer {
+	return managed.InitializerFn(func(ctx context.Context, mg resource.Managed) error {
+		cr, ok := mg.(*v1alpha1.TemporalNamespace)
+		if !ok || !meta.ExternalCreateIncomplete(cr) {
+			return nil
+		}
+
+		if externalName := meta.GetExternalName(cr); externalName == "" || externalName != cr.Spec.ForProvider.Name {
+			return nil
+		}
+
+		meta.SetExternalCreateFailed(cr, time.Now())
+		return errors.Wrap(kube.Update(ctx, cr), errRecoverCreate)
+	})
+}
+
+// adoptExternalName initializes the name of the namespace with the external
+// name, which allows to adopt an existing namespace by setting only the
+// external name. It returns true, if the name was initialized.
+func adoptExternalName(cr *v1alpha1.TemporalNamespace) (bool, error) {
+	externalName := meta.GetExternalName(cr)
+	if cr.Spec.ForProvider.Name != "" {
+		if externalName != "" && externalName != cr.Spec.ForProvider.Name {
+			return false, errors.Errorf(errNameMismatch, cr.Spec.ForProvider.Name, externalName)
+		}
+		return false, nil
+	}
+
+	if externalName == "" {
+		return false, errors.New(errNameRequired)
+	}
+
+	if externalName == reservedNamespaceName {
+		return false, errors.Errorf(errNameReserved, externalName)
+	}
+
+	cr.Spec.ForProvider.Name = externalName
+	return true, nil
+}
+
+// refuseAdoption returns true, if an existing namespace must not be adopted.
+// Namespaces created by the TemporalNamespace or adopted explicitly have an
+// external name.
+func refuseAdoption(cr *v1alpha1.TemporalNamespace) bool {
+	return cr.Spec.ForProvider.AdoptionPolicy == v1alpha1.AdoptionPolicyStrict && meta.GetExternalName(cr) == ""
+}
+
+// retentionRejected returns true, if the retention of the spec was rejected
+// by the server before
+func retentionRejected(cr *v1alpha1.TemporalNamespace, spec *temporal.NamespaceCompare) bool {
+	rejected := cr.Status.RejectedWorkflowExecutionRetention
+	return rejected != nil && spec.WorkflowExecutionRetention != nil && rejected.Duration == spec.WorkflowExecutionRetention.Duration
+}
+
+// keepObservedRetention replaces the retention of the namespace with the
+// observed retention
+func keepObservedRetention(namespace *v1alpha1.TemporalNamespaceParameters, observed *v1alpha1.TemporalNamespaceObservation) {
+	namespace.WorkflowExecutionRetentionDays = 0
+	namespace.WorkflowExecutionRetention = observed.WorkflowExecutionRetention
+}
+
+// lateInitialize fills the optional fields, which are not set in the spec,
+// with the values observed on the server. This way server defaults are not
+// reported as drift.
+func lateInitialize(spec *v1alpha1.TemporalNamespaceParameters, observed *v1alpha1.TemporalNamespaceObservation) bool {
+	lateInitialized := lateInitializeString(&spec.Description, observed.Description)
+	lateInitialized = lateInitializeString(&spec.OwnerEmail, observed.OwnerEmail) || lateInitialized
+	lateInitialized = lateInitializeString(&spec.HistoryArchivalUri, observed.HistoryArchivalUri) || lateInitialized
+	lateInitialized = lateInitializeString(&spec.VisibilityArchivalUri, observed.VisibilityArchivalUri) || lateInitialized
+
+	// Merged data is not initialized, because it would take the ownership
+	// of keys written by others. Data is not initialized either, if it is
+	// partly sourced from dataFrom, because the keys of both must not overlap
+	if spec.Data == nil && observed.Data != nil && spec.DataMergeStrategy != v1alpha1.DataMergeStrategyMerge && len(spec.DataFrom) == 0 {
+		data := make(map[string]string, len(*observed.Data))
+		for key, value := range *observed.Data {
+			// Cleared keys are regarded as removed
+			if value != "" {
+				data[key] = value
+			}
+		}
+		spec.Data = &data
+		lateInitialized = true
+	}
+
+	return lateInitialized
+}
+
+func lateInitializeString(spec **string, observed *string) bool {
+	if *spec != nil || observed == nil {
+		return false
+	}
+
+	value := *observed
+	*spec = &value
+	return true
+}
+
+// resolveParameters returns a copy of the parameters, whose data contains the
+// entries sourced from the ConfigMaps and Secrets of dataFrom.
+func (c *external) resolveParameters(ctx context.Context, cr *v1alpha1.TemporalNamespace) (*v1alpha1.TemporalNamespaceParameters, error) {
+	namespace := cr.Spec.ForProvider.DeepCopy()
+	if len(namespace.DataFrom) == 0 {
+		return namespace, nil
+	}
+
+	data := map[string]string{}
+	if namespace.Data != nil {
+		for key, value := range *namespace.Data {
+			data[key] = value
+		}
+	}
+
+	for _, dataFrom := range namespace.DataFrom {
+		value, err := c.getDataFromValue(ctx, dataFrom)
+		if err != nil {
+			return nil, err
+		}
+		data[dataFrom.Key] = value
+	}
+
+	namespace.Data = &data
+	namespace.DataFrom = nil
+	return namespace, nil
+}
+
+// getDataFromValue reads the value of a dataFrom entry from its ConfigMap or
+// Secret
+func (c *external) getDataFromValue(ctx context.Context, dataFrom v1alpha1.NamespaceDataFrom) (string, error) {
+	if dataFrom.SecretKeyRef != nil {
+		ref := dataFrom.SecretKeyRef
+		secret := &corev1.Secret{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+			return "", errors.Wrapf(err, errGetDataFromSecret, dataFrom.Key)
+		}
+
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return "", errors.Errorf(errDataFromKeyNotFound, ref.Key, dataFrom.Key)
+		}
+		return string(value), nil
+	}
+
+	ref := dataFrom.ConfigMapKeyRef
+	configMap := &corev1.ConfigMap{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, configMap); err != nil {
+		return "", errors.Wrapf(err, errGetDataFromConfigMap, dataFrom.Key)
+	}
+
+	value, ok := configMap.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errDataFromKeyNotFound, ref.Key, dataFrom.Key)
+	}
+	return value, nil
+}
+
+// dataKeys returns the sorted keys of the data
+func dataKeys(data *map[string]string) []string {
+	if data == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(*data))
+	for key := range *data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// clearRemovedDataKeys adds the given keys, which are not in the data, with
+// an empty value. Temporal merges the data of an update into the
+// existing data, therefore keys can only be cleared, but not removed.
+func clearRemovedDataKeys(data *map[string]string, managedKeys []string) *map[string]string {
+	result := map[string]string{}
+	for _, key := range managedKeys {
+		result[key] = ""
+	}
+
+	if data != nil {
+		for key, value := range *data {
+			result[key] = value
+		}
+	}
+
+	if len(result) == 0 {
+		return data
+	}
+	return &result
+}
+
+// observeDeletion reports the namespace as existing until Temporal reclaimed
+// its resources. Deleted namespaces are renamed by Temporal, therefore they are
+// tracked by their id. Namespaces, which were never observed, are regarded as
+// deleted once they are in state Deleted.
+func (c *external) observeDeletion(ctx context.Context, cr *v1alpha1.TemporalNamespace) (managed.ExternalObservation, error) {
+	// A namespace, which was refused to adopt, must not be deleted
+	if refuseAdoption(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:   false,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	var observed *v1alpha1.TemporalNamespaceObservation
+	var err error
+	if cr.Status.AtProvider.Id != "" {
+		observed, err = c.service.DescribeNamespaceById(ctx, cr.Status.AtProvider.Id)
+	} else {
+		observed, err = c.service.DescribeNamespaceByName(ctx, cr.Spec.ForProvider.Name)
+		if observed != nil && observed.State == "Deleted" {
+			observed = nil
+		}
+	}
+
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribe)
+	}
+
+	if observed == nil {
+		c.logger.Debug("Managed resource '" + cr.Name + "' is deleted")
+		_, _ = asyncoperation.Progress(&cr.Status.AsyncOperation, asyncoperation.StateFulfilled, "")
+		return managed.ExternalObservation{
+			ResourceExists:    false,
+			ResourceUpToDate:  false,
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+
+	c.logger.Debug("Managed resource '" + cr.Name + "' is still being deleted")
+	message := "Namespace.State = " + observed.State
+	if asyncoperation.Running(&cr.Status.AsyncOperation) {
+		_, _ = asyncoperation.Progress(&cr.Status.AsyncOperation, asyncoperation.StateInProgress, "")
+		message = "Deletion of TemporalNamespace is " + cr.Status.AsyncOperation.State + ", " + message
+	}
+	cr.SetConditions(xpv1.Deleting().WithMessage(message))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
 	}, nil
 }
 
@@ -284,7 +852,19 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotTemporalNamespace)
 	}
 
-	err := c.service.CreateNamespace(ctx, &cr.Spec.ForProvider)
+	namespace, err := c.resolveParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	err = c.service.CreateNamespace(ctx, namespace)
+
+	var alreadyExists *serviceerror.NamespaceAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		// The namespace was not created by the TemporalNamespace, therefore
+		// the recorded external name must not adopt it.
+		meta.RemoveAnnotations(cr, meta.AnnotationKeyExternalName)
+	}
 
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
@@ -296,7 +876,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalCreation{
 		// Optionally return any details that may be required to connect to the
 		// external resource. These will be stored as the connection secret.
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: c.connectionDetails(cr),
 	}, nil
 }
 
@@ -308,7 +888,38 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotTemporalNamespace)
 	}
 
-	err := c.service.UpdateNamespaceByName(ctx, &cr.Spec.ForProvider)
+	namespace, err := c.resolveParameters(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Replace clears all observed keys, which are not in the spec, including
+	// keys written by others
+	if namespace.DataMergeStrategy == v1alpha1.DataMergeStrategyMerge {
+		namespace.Data = clearRemovedDataKeys(namespace.Data, cr.Status.ManagedDataKeys)
+	} else {
+		namespace.Data = clearRemovedDataKeys(namespace.Data, dataKeys(cr.Status.AtProvider.Data))
+	}
+
+	specCompareable, err := c.service.MapToNamespaceCompare(namespace)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errMapping)
+	}
+
+	if retentionRejected(cr, specCompareable) {
+		keepObservedRetention(namespace, &cr.Status.AtProvider)
+	}
+
+	err = c.service.UpdateNamespaceByName(ctx, namespace)
+
+	// Remember the rejected retention to not retry it, but reconcile the
+	// other fields with the observed retention
+	if temporal.IsRetentionRejected(err) {
+		c.logger.Debug("Retention of managed resource '" + cr.Name + "' rejected: " + err.Error())
+		cr.Status.RejectedWorkflowExecutionRetention = specCompareable.WorkflowExecutionRetention
+		keepObservedRetention(namespace, &cr.Status.AtProvider)
+		err = c.service.UpdateNamespaceByName(ctx, namespace)
+	}
 
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
@@ -330,12 +941,144 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotTemporalNamespace)
 	}
 
-	_, err := c.service.DeleteNamespaceByName(ctx, cr.Spec.ForProvider.Name)
+	// The namespace is not deleted again, while Temporal reclaims its
+	// resources
+	if asyncoperation.Running(&cr.Status.AsyncOperation) {
+		c.logger.Debug("Managed resource '" + cr.Name + "' is still being deleted")
+		return nil
+	}
+
+	// Search attributes are removed together with their namespace, therefore
+	// their managed resources are deleted first
+	dependents, err := c.dependents(ctx, cr)
+	if err != nil {
+		return errors.Wrap(err, errListDependents)
+	}
+
+	if len(dependents) > 0 {
+		err := errors.Errorf(errInUse, cr.Spec.ForProvider.Name, strings.Join(dependents, ", "))
+		condition := xpv1.Deleting().WithMessage(err.Error())
+		condition.Reason = reasonInUse
+		cr.SetConditions(condition)
+		return err
+	}
+
+	deleted, err := c.service.DeleteNamespaceByName(ctx, cr.Spec.ForProvider.Name)
 
 	if err != nil {
 		return errors.Wrap(err, errDelete)
 	}
 
+	// Temporal reclaims the resources of the namespace asynchronously. The
+	// deletion is tracked by the id of the namespace, because Temporal
+	// renames it.
+	if deleted != nil {
+		asyncoperation.Track(&cr.Status.AsyncOperation, deletionId(cr, *deleted))
+	}
+
 	c.logger.Debug("Managed resource '" + cr.Name + "' deleted")
 	return nil
 }
+
+// deletionId returns the id, by which the deletion of the namespace is
+// tracked. It falls back to the name of the namespace, if it was never
+// observed.
+func deletionId(cr *v1alpha1.TemporalNamespace, name string) string {
+	if cr.Status.AtProvider.Id != "" {
+		return cr.Status.AtProvider.Id
+	}
+	return name
+}
+
+// dependents returns the sorted SearchAttributes and SearchAttributeSets,
+// which belong to the namespace of the TemporalNamespace.
+func (c *external) dependents(ctx context.Context, cr *v1alpha1.TemporalNamespace) ([]string, error) {
+	var dependents []string
+
+	searchAttributes := &v1alpha1.SearchAttributeList{}
+	if err := c.kube.List(ctx, searchAttributes); err != nil {
+		return nil, err
+	}
+
+	for i := range searchAttributes.Items {
+		searchAttribute := &searchAttributes.Items[i]
+		if !sameProviderConfig(cr, searchAttribute) {
+			continue
+		}
+
+		parameters := searchAttribute.Spec.ForProvider
+		if dependsOn(cr, parameters.TemporalNamespaceName, parameters.TemporalNamespaceNameRef) || dependsOnAny(cr, parameters.TemporalNamespaceNames, parameters.TemporalNamespaceNamesRefs) {
+			dependents = append(dependents, v1alpha1.SearchAttributeKind+"/"+searchAttribute.GetName())
+		}
+	}
+
+	searchAttributeSets := &v1alpha1.SearchAttributeSetList{}
+	if err := c.kube.List(ctx, searchAttributeSets); err != nil {
+		return nil, err
+	}
+
+	for i := range searchAttributeSets.Items {
+		searchAttributeSet := &searchAttributeSets.Items[i]
+		if !sameProviderConfig(cr, searchAttributeSet) {
+			continue
+		}
+
+		if dependsOn(cr, searchAttributeSet.Spec.ForProvider.TemporalNamespaceName, searchAttributeSet.Spec.ForProvider.TemporalNamespaceNameRef) {
+			dependents = append(dependents, v1alpha1.SearchAttributeSetKind+"/"+searchAttributeSet.GetName())
+		}
+	}
+
+	sort.Strings(dependents)
+	return dependents, nil
+}
+
+// sameProviderConfig returns true, if the dependent resource uses the
+// ProviderConfig of the TemporalNamespace. Resources of other ProviderConfigs
+// manage namespaces of the same name in other Temporal clusters.
+func sameProviderConfig(cr *v1alpha1.TemporalNamespace, dependent resource.Managed) bool {
+	return providerConfigName(cr) == providerConfigName(dependent)
+}
+
+// providerConfigName returns the name of the ProviderConfig of the managed
+// resource, taking a not yet migrated providerRef into account.
+func providerConfigName(mg resource.Managed) string {
+	if ref, ok := mg.DeepCopyObject().(providerref.Referencer); ok {
+		providerref.Migrate(ref)
+		mg = ref
+	}
+
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return providerref.DefaultName
+}
+
+// dependsOn returns true, if the namespace name or the reference of a
+// dependent resource points to the TemporalNamespace.
+func dependsOn(cr *v1alpha1.TemporalNamespace, name *string, ref *xpv1.Reference) bool {
+	if ref != nil && ref.Name == cr.GetName() {
+		return true
+	}
+
+	if name == nil {
+		return false
+	}
+	return *name == cr.Spec.ForProvider.Name || *name == meta.GetExternalName(cr)
+}
+
+// dependsOnAny returns true, if one of the namespace names or references of a
+// dependent resource in multiple namespaces points to the TemporalNamespace.
+func dependsOnAny(cr *v1alpha1.TemporalNamespace, names []string, refs []xpv1.Reference) bool {
+	for i := range names {
+		if dependsOn(cr, &names[i], nil) {
+			return true
+		}
+	}
+
+	for i := range refs {
+		if dependsOn(cr, nil, &refs[i]) {
+			return true
+		}
+	}
+	return false
+}
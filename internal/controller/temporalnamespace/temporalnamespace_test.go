@@ -0,0 +1,501 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package temporalnamespace
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/syncmap"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/denniskniep/provider-temporal/apis"
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+	apisv1alpha1 "github.com/denniskniep/provider-temporal/apis/v1alpha1"
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+)
+
+func newTemporalNamespace(name string) *v1alpha1.TemporalNamespace {
+	cr := &v1alpha1.TemporalNamespace{}
+	cr.SetName(name)
+	cr.SetUID(types.UID("uid-" + name))
+	cr.Spec.ForProvider.Name = name
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "test"})
+	return cr
+}
+
+// fakeNamespaceService serves the namespaces of a test. Its other methods are
+// not implemented.
+type fakeNamespaceService struct {
+	temporal.NamespaceService
+
+	namespaces map[string]*v1alpha1.TemporalNamespaceObservation
+}
+
+func (s *fakeNamespaceService) DescribeNamespaceByName(_ context.Context, name string) (*v1alpha1.TemporalNamespaceObservation, error) {
+	return s.namespaces[name], nil
+}
+
+func (s *fakeNamespaceService) CreateNamespace(_ context.Context, namespace *v1alpha1.TemporalNamespaceParameters) error {
+	if s.namespaces == nil {
+		s.namespaces = map[string]*v1alpha1.TemporalNamespaceObservation{}
+	}
+	s.namespaces[namespace.Name] = &v1alpha1.TemporalNamespaceObservation{Id: "id-" + namespace.Name, Name: namespace.Name, State: "Registered"}
+	return nil
+}
+
+// UpdateNamespaceByName merges the data into the observed data like Temporal,
+// which does not remove keys.
+func (s *fakeNamespaceService) UpdateNamespaceByName(_ context.Context, namespace *v1alpha1.TemporalNamespaceParameters) error {
+	observed := s.namespaces[namespace.Name]
+	if namespace.Data == nil {
+		return nil
+	}
+
+	data := map[string]string{}
+	if observed.Data != nil {
+		data = *observed.Data
+	}
+	for key, value := range *namespace.Data {
+		data[key] = value
+	}
+	observed.Data = &data
+	return nil
+}
+
+func (s *fakeNamespaceService) MapToNamespaceCompare(namespace interface{}) (*temporal.NamespaceCompare, error) {
+	return (&temporal.TemporalServiceImpl{}).MapToNamespaceCompare(namespace)
+}
+
+func (s *fakeNamespaceService) HostPort() string {
+	return "temporal:7233"
+}
+
+func (s *fakeNamespaceService) TLSCertificates() *temporal.TLSCertificates {
+	return nil
+}
+
+// newExternal returns an external client, whose kube client serves the
+// ProviderConfig and the TemporalNamespaces.
+func newExternal(pc *apisv1alpha1.ProviderConfig, service temporal.NamespaceService, namespaces ...*v1alpha1.TemporalNamespace) *external {
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			pc.DeepCopyInto(obj.(*apisv1alpha1.ProviderConfig))
+			return nil
+		},
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			l := obj.(*v1alpha1.TemporalNamespaceList)
+			for _, ns := range namespaces {
+				l.Items = append(l.Items, *ns.DeepCopy())
+			}
+			return nil
+		},
+	}
+	return &external{service: service, kube: kube, quota: &quota{kube: kube}, logger: logging.NewNopLogger()}
+}
+
+func newProviderConfig(pattern string) *apisv1alpha1.ProviderConfig {
+	pc := &apisv1alpha1.ProviderConfig{}
+	pc.SetName("test")
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceNone
+	if pattern != "" {
+		pc.Spec.AllowedNamespaceNamePattern = &pattern
+	}
+	return pc
+}
+
+func TestValidateName(t *testing.T) {
+	deleted := newTemporalNamespace("other")
+	now := metav1.NewTime(time.Now())
+	deleted.SetDeletionTimestamp(&now)
+
+	cases := map[string]struct {
+		pattern  string
+		cr       *v1alpha1.TemporalNamespace
+		terminal bool
+	}{
+		"NoPattern":       {pattern: "", cr: newTemporalNamespace("other")},
+		"Matching":        {pattern: "team-.*", cr: newTemporalNamespace("team-a")},
+		"NotMatching":     {pattern: "team-.*", cr: newTemporalNamespace("other"), terminal: true},
+		"PartialMatch":    {pattern: "team", cr: newTemporalNamespace("team-a"), terminal: true},
+		"InvalidPattern":  {pattern: "team-(", cr: newTemporalNamespace("team-a"), terminal: true},
+		"DeletedNotValid": {pattern: "team-.*", cr: deleted},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateName(newProviderConfig(tc.pattern), tc.cr)
+			if (err != nil) != tc.terminal {
+				t.Fatalf("Expected error %t, got %v", tc.terminal, err)
+			}
+			if err != nil && !temporal.IsTerminalError(err) {
+				t.Fatalf("Expected terminal error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestConnectRejectsNotAllowedNameWithoutRetry(t *testing.T) {
+	pc := newProviderConfig("team-.*")
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			pc.DeepCopyInto(obj.(*apisv1alpha1.ProviderConfig))
+			return nil
+		},
+	}
+
+	connected := 0
+	c := &connector{
+		kube:                   kube,
+		usage:                  resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+		logger:                 logging.NewNopLogger(),
+		externalClientsByCreds: syncmap.Map{},
+		newServiceFn: func(_ []byte) (temporal.NamespaceService, error) {
+			connected++
+			return nil, errors.New("unexpected connect")
+		},
+	}
+
+	cr := newTemporalNamespace("other")
+	_, err := terminal.NewTracker().NewConnector(c).Connect(context.Background(), cr)
+	if !temporal.IsTerminalError(err) {
+		t.Fatalf("Expected terminal error, got %v", err)
+	}
+	if connected != 0 {
+		t.Fatalf("Expected no connection to Temporal, got %d", connected)
+	}
+
+	ready := cr.GetCondition(xpv1.TypeReady)
+	if ready.Status != corev1.ConditionFalse || ready.Reason != terminal.ReasonTerminalError {
+		t.Fatalf("Expected Ready condition with reason %s, got %s: %s", terminal.ReasonTerminalError, ready.Status, ready.Reason)
+	}
+	if meta.GetExternalName(cr) != "" {
+		t.Fatalf("Expected rejected TemporalNamespace to get no external name, got %q", meta.GetExternalName(cr))
+	}
+}
+
+func TestQuotaSerialisesConcurrentCreates(t *testing.T) {
+	pc := newProviderConfig("")
+	maxNamespaces := 1
+	pc.Spec.MaxNamespaces = &maxNamespaces
+
+	first := newTemporalNamespace("first")
+	second := newTemporalNamespace("second")
+	ext := newExternal(pc, &fakeNamespaceService{}, first, second)
+
+	// The lists of concurrent checks overlap, unless they are serialised
+	var listing, overlapped int32
+	kube := ext.kube.(*test.MockClient)
+	list := kube.MockList
+	kube.MockList = func(ctx context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+		if atomic.AddInt32(&listing, 1) > 1 {
+			atomic.StoreInt32(&overlapped, 1)
+		}
+		defer atomic.AddInt32(&listing, -1)
+		time.Sleep(10 * time.Millisecond)
+		return list(ctx, obj, opts...)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, cr := range []*v1alpha1.TemporalNamespace{first.DeepCopy(), second.DeepCopy()} {
+		wg.Add(1)
+		go func(i int, cr *v1alpha1.TemporalNamespace) {
+			defer wg.Done()
+			_, errs[i] = ext.Observe(context.Background(), cr)
+		}(i, cr)
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&overlapped) != 0 {
+		t.Fatalf("Expected concurrent checks to be serialised")
+	}
+
+	rejected := 0
+	for _, err := range errs {
+		if err != nil {
+			rejected++
+		}
+	}
+	if rejected != 1 {
+		t.Fatalf("Expected exactly one of the concurrent creates to be rejected, got %v", errs)
+	}
+}
+
+func TestQuotaCountsAdoptedNamespaces(t *testing.T) {
+	existing := &v1alpha1.TemporalNamespaceObservation{Id: "id-existing", Name: "existing", State: "Registered"}
+	service := &fakeNamespaceService{namespaces: map[string]*v1alpha1.TemporalNamespaceObservation{"existing": existing}}
+
+	created := newTemporalNamespace("created")
+	meta.SetExternalName(created, "created")
+
+	cases := map[string]struct {
+		maxNamespaces int
+		adopted       bool
+	}{
+		"QuotaExceeded": {maxNamespaces: 1, adopted: false},
+		"WithinQuota":   {maxNamespaces: 2, adopted: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := newProviderConfig("")
+			pc.Spec.MaxNamespaces = &tc.maxNamespaces
+
+			// The namespace is adopted by its external name
+			cr := &v1alpha1.TemporalNamespace{}
+			cr.SetName("adopted")
+			cr.SetUID(types.UID("uid-adopted"))
+			cr.SetProviderConfigReference(&xpv1.Reference{Name: "test"})
+			meta.SetExternalName(cr, "existing")
+
+			_, err := newExternal(pc, service, created, cr).Observe(context.Background(), cr)
+			if (err == nil) != tc.adopted {
+				t.Fatalf("Expected adopted %t, got %v", tc.adopted, err)
+			}
+
+			if adopted := cr.Status.AtProvider.Id == existing.Id; adopted != tc.adopted {
+				t.Fatalf("Expected adopted %t, got observed id %q", tc.adopted, cr.Status.AtProvider.Id)
+			}
+			// An exceeded quota is not retried with backoff, but checked
+			// again on the next poll
+			if !tc.adopted && !temporal.IsTerminalError(err) {
+				t.Fatalf("Expected terminal error, got %v", err)
+			}
+			if !tc.adopted && cr.GetCondition(xpv1.TypeReady).Reason != reasonQuotaExceeded {
+				t.Fatalf("Expected Ready condition with reason %s, got %s", reasonQuotaExceeded, cr.GetCondition(xpv1.TypeReady).Reason)
+			}
+		})
+	}
+}
+
+func TestConnectionDetailsArePublished(t *testing.T) {
+	sch := runtime.NewScheme()
+	if err := corev1.AddToScheme(sch); err != nil {
+		t.Fatal(err)
+	}
+	if err := apis.AddToScheme(sch); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		publish func(cr *v1alpha1.TemporalNamespace)
+	}{
+		"ConnectionSecret": {
+			publish: func(cr *v1alpha1.TemporalNamespace) {
+				cr.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "connection", Namespace: "crossplane-system"})
+			},
+		},
+		"ExternalSecretStore": {
+			publish: func(cr *v1alpha1.TemporalNamespace) {
+				cr.SetPublishConnectionDetailsTo(&xpv1.PublishConnectionDetailsTo{Name: "connection", SecretStoreConfigRef: &xpv1.Reference{Name: "vault"}})
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := newTemporalNamespace("team-a")
+			tc.publish(cr)
+
+			// The publishers of the connection secret and of the External
+			// Secret Store, like they are set up by the controller
+			published := map[string]string{}
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if sc, ok := obj.(*apisv1alpha1.StoreConfig); ok {
+						sc.SetName(key.Name)
+						return nil
+					}
+					return kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, key.Name)
+				},
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					for key, value := range obj.(*corev1.Secret).Data {
+						published[key] = string(value)
+					}
+					return nil
+				},
+				MockScheme: test.NewMockSchemeFn(sch),
+			}
+			store := &fake.SecretStore{
+				WriteKeyValuesFn: func(_ context.Context, s *store.Secret, _ ...store.WriteOption) (bool, error) {
+					for key, value := range s.Data {
+						published[key] = string(value)
+					}
+					return true, nil
+				},
+			}
+			publishers := []managed.ConnectionPublisher{
+				managed.NewAPISecretPublisher(kube, sch),
+				connection.NewDetailsManager(kube, apisv1alpha1.StoreConfigGroupVersionKind, connection.WithStoreBuilder(
+					func(_ context.Context, _ client.Client, _ *tls.Config, _ xpv1.SecretStoreConfig) (connection.Store, error) {
+						return store, nil
+					})),
+			}
+
+			ext := newExternal(newProviderConfig(""), &fakeNamespaceService{}, cr)
+			observation, err := ext.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if observation.ResourceExists {
+				t.Fatalf("Expected namespace not to exist before it is created")
+			}
+
+			creation, err := ext.Create(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			observation, err = ext.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, details := range []managed.ConnectionDetails{creation.ConnectionDetails, observation.ConnectionDetails} {
+				for key := range published {
+					delete(published, key)
+				}
+				for _, p := range publishers {
+					if _, err := p.PublishConnection(context.Background(), cr, details); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				want := map[string]string{connectionDetailHostPort: "temporal:7233", connectionDetailNamespace: "team-a"}
+				if diff := cmp.Diff(want, published); diff != "" {
+					t.Fatalf("Unexpected published connection details: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDependentsOfOtherProviderConfigsAreIgnored(t *testing.T) {
+	cr := newTemporalNamespace("orders")
+
+	namespace := "orders"
+	searchAttribute := func(name string, pc string) v1alpha1.SearchAttribute {
+		sa := v1alpha1.SearchAttribute{}
+		sa.SetName(name)
+		sa.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+		sa.Spec.ForProvider.TemporalNamespaceName = &namespace
+		return sa
+	}
+
+	kube := &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			switch l := obj.(type) {
+			case *v1alpha1.SearchAttributeList:
+				// Both ProviderConfigs manage a namespace of the same name,
+				// but in different Temporal clusters
+				l.Items = []v1alpha1.SearchAttribute{
+					searchAttribute("same-cluster", "test"),
+					searchAttribute("other-cluster", "other"),
+				}
+			case *v1alpha1.SearchAttributeSetList:
+				set := v1alpha1.SearchAttributeSet{}
+				set.SetName("other-cluster-set")
+				set.SetProviderConfigReference(&xpv1.Reference{Name: "other"})
+				set.Spec.ForProvider.TemporalNamespaceNameRef = &xpv1.Reference{Name: cr.GetName()}
+				l.Items = []v1alpha1.SearchAttributeSet{set}
+			}
+			return nil
+		},
+	}
+
+	e := &external{kube: kube, logger: logging.NewNopLogger()}
+	dependents, err := e.dependents(context.Background(), cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{v1alpha1.SearchAttributeKind + "/same-cluster"}
+	if diff := cmp.Diff(want, dependents); diff != "" {
+		t.Fatalf("dependents: -want, +got:\n%s", diff)
+	}
+}
+
+func TestReplaceClearsDataKeysNotInSpec(t *testing.T) {
+	cr := newTemporalNamespace("team-a")
+	meta.SetExternalName(cr, "team-a")
+	cr.Spec.ForProvider.Data = &map[string]string{"team": "a"}
+	cr.Spec.ForProvider.DataMergeStrategy = v1alpha1.DataMergeStrategyReplace
+	cr.Spec.ForProvider.HistoryArchivalState = "Disabled"
+	cr.Spec.ForProvider.VisibilityArchivalState = "Disabled"
+	cr.Spec.ForProvider.WorkflowExecutionRetention = &metav1.Duration{Duration: 24 * time.Hour}
+
+	service := &fakeNamespaceService{namespaces: map[string]*v1alpha1.TemporalNamespaceObservation{
+		"team-a": {
+			Id:                         "id-team-a",
+			Name:                       "team-a",
+			State:                      "Registered",
+			Data:                       &map[string]string{"team": "a", "foreign": "x"},
+			HistoryArchivalState:       "Disabled",
+			VisibilityArchivalState:    "Disabled",
+			WorkflowExecutionRetention: &metav1.Duration{Duration: 24 * time.Hour},
+		},
+	}}
+	ext := newExternal(newProviderConfig(""), service, cr)
+
+	observation, err := ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if observation.ResourceUpToDate {
+		t.Fatalf("Expected key foreign, which is not in the spec, to be drift")
+	}
+
+	if _, err := ext.Update(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{"team": "a", "foreign": ""}
+	if diff := cmp.Diff(want, *service.namespaces["team-a"].Data); diff != "" {
+		t.Fatalf("Expected key foreign to be cleared: -want, +got:\n%s", diff)
+	}
+
+	observation, err = ext.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !observation.ResourceUpToDate {
+		t.Fatalf("Expected cleared key to be regarded as removed, got diff:\n%s", observation.Diff)
+	}
+}
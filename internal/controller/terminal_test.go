@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"go.temporal.io/api/serviceerror"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/denniskniep/provider-temporal/internal/terminal"
+)
+
+// expectRejected fails, if the reconcile of the managed resource was retried
+// with backoff or if the managed resource is reported as synced.
+func expectRejected(t *testing.T, st *store, result reconcile.Result, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Requeue || result.RequeueAfter != testPollInterval {
+		t.Fatalf("Expected rejected reconcile to be requeued after the poll interval, got %+v", result)
+	}
+	if synced := st.mg.GetCondition(xpv1.TypeSynced); synced.Status != corev1.ConditionFalse {
+		t.Fatalf("Expected rejected managed resource not to be synced, got %s: %s", synced.Reason, synced.Message)
+	}
+}
+
+// expectTerminalCondition fails, if the managed resource is not reported as
+// unavailable because of a terminal error.
+func expectTerminalCondition(t *testing.T, st *store) {
+	t.Helper()
+
+	if ready := st.mg.GetCondition(xpv1.TypeReady); ready.Status != corev1.ConditionFalse || ready.Reason != terminal.ReasonTerminalError {
+		t.Fatalf("Expected Ready condition with reason %s, got %s: %s", terminal.ReasonTerminalError, ready.Status, ready.Reason)
+	}
+}
+
+func TestRejectedConnectIsNotRetriedWithBackoff(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{connectErr: serviceerror.NewInvalidArgument("name is not allowed")}
+			r, st := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			result, err := r.Reconcile(context.Background(), testRequest)
+			expectRejected(t, st, result, err)
+			expectTerminalCondition(t, st)
+			if e.observe != 0 {
+				t.Fatalf("Expected no observe after rejected connect, got %d", e.observe)
+			}
+		})
+	}
+}
+
+func TestRejectedCreateIsNotRetried(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: false, createErr: serviceerror.NewPermissionDenied("permission denied", "")}
+			r, st := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			for i := 0; i < 2; i++ {
+				result, err := r.Reconcile(context.Background(), testRequest)
+				expectRejected(t, st, result, err)
+			}
+
+			// The reconciler reports the failed create as Creating, the next
+			// observe reports the terminal error.
+			expectTerminalCondition(t, st)
+			if e.create != 1 {
+				t.Fatalf("Expected rejected create not to be sent again, got %d creates", e.create)
+			}
+
+			// A changed spec sends the create again
+			st.mg.SetGeneration(st.mg.GetGeneration() + 1)
+			_, _ = r.Reconcile(context.Background(), testRequest)
+			if e.create != 2 {
+				t.Fatalf("Expected create of the changed spec, got %d creates", e.create)
+			}
+		})
+	}
+}
+
+func TestRejectedObserveIsNotReportedAsExisting(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true, updateErr: serviceerror.NewInvalidArgument("invalid argument")}
+			r, st := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			result, err := r.Reconcile(context.Background(), testRequest)
+			expectRejected(t, st, result, err)
+			expectTerminalCondition(t, st)
+
+			// The rejected update is reported by the observe of the next
+			// reconcile, without being sent again.
+			result, err = r.Reconcile(context.Background(), testRequest)
+			expectRejected(t, st, result, err)
+			expectTerminalCondition(t, st)
+			if e.update != 1 {
+				t.Fatalf("Expected rejected update not to be sent again, got %d updates", e.update)
+			}
+		})
+	}
+}
+
+// reasonedError is a terminal error, which reports its own reason.
+type reasonedError struct {
+	reason xpv1.ConditionReason
+}
+
+func (e *reasonedError) Error() string {
+	return "quota exceeded"
+}
+
+func (e *reasonedError) Terminal() bool {
+	return true
+}
+
+func (e *reasonedError) Reason() xpv1.ConditionReason {
+	return e.reason
+}
+
+func TestRejectedObserveKeepsReasonOfError(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{observeErr: &reasonedError{reason: "QuotaExceeded"}}
+			r, st := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			result, err := r.Reconcile(context.Background(), testRequest)
+			expectRejected(t, st, result, err)
+			if ready := st.mg.GetCondition(xpv1.TypeReady); ready.Status != corev1.ConditionFalse || ready.Reason != "QuotaExceeded" {
+				t.Fatalf("Expected Ready condition with reason QuotaExceeded, got %s: %s", ready.Status, ready.Reason)
+			}
+			if e.create != 0 {
+				t.Fatalf("Expected no create after rejected observe, got %d", e.create)
+			}
+		})
+	}
+}
+
+func TestDeletedManagedResourcesWithoutExternalNameAreNotDeleted(t *testing.T) {
+	s := newScheme(t)
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true}
+			mg := newManaged(t, s, gvk)
+			setDeleted(mg, xpv1.DeletionDelete)
+			meta.SetExternalName(mg, "")
+
+			// Without the initializer, which sets the name as external name
+			r, st := newReconciler(s, gvk, mg, e, managed.WithInitializers())
+
+			if _, err := r.Reconcile(context.Background(), testRequest); err != nil {
+				t.Fatal(err)
+			}
+
+			if e.delete != 0 {
+				t.Fatalf("Expected no delete of managed resource without external name, got %d", e.delete)
+			}
+			if meta.FinalizerExists(st.mg, managedFinalizer) {
+				t.Fatalf("Expected finalizer to be removed")
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/denniskniep/provider-temporal/internal/timeout"
+)
+
+func TestSyncTimeoutRetriesStuckReconciles(t *testing.T) {
+	s := newScheme(t)
+
+	timeout.SetSyncTimeout(100 * time.Millisecond)
+	t.Cleanup(func() { timeout.SetSyncTimeout(0) })
+
+	for _, gvk := range managedKinds(t, s) {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			e := &fakeExternal{exists: true, stuck: true}
+			r, st := newReconciler(s, gvk, newManaged(t, s, gvk), e)
+
+			start := time.Now()
+			result, err := r.Reconcile(context.Background(), testRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Fatalf("Expected reconcile to be canceled after the sync timeout, took %s", elapsed)
+			}
+			if !result.Requeue {
+				t.Fatalf("Expected timed out reconcile to be retried, got %+v", result)
+			}
+
+			synced := st.mg.GetCondition(xpv1.TypeSynced)
+			if synced.Reason != xpv1.ReasonReconcileError || !strings.Contains(synced.Message, "sync timeout of 100ms exceeded") {
+				t.Fatalf("Expected Synced condition with sync timeout error, got %s: %s", synced.Reason, synced.Message)
+			}
+		})
+	}
+}
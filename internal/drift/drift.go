@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift reports the differences between the spec of managed resources
+// and their external resources as events, so that they are visible without
+// debug logging.
+package drift
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// maxDiffLength limits the length of the diff of an event.
+	maxDiffLength = 1024
+
+	msgDrift     = "External resource differs from the desired state:\n"
+	msgTruncated = "\n... (truncated)"
+
+	// reasonDrift indicates that the external resource differs from the spec
+	// of the managed resource and is updated.
+	reasonDrift event.Reason = "DriftDetected"
+)
+
+// NewConnector returns a connector, whose external clients record the diff of
+// each observation, which is not up to date.
+func NewConnector(record event.Recorder, c managed.ExternalConnectDisconnecter) managed.ExternalConnectDisconnecter {
+	return &connector{ExternalConnectDisconnecter: c, record: record}
+}
+
+type connector struct {
+	managed.ExternalConnectDisconnecter
+	record event.Recorder
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	client, err := c.ExternalConnectDisconnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: client, record: c.record}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	record event.Recorder
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	observation, err := e.ExternalClient.Observe(ctx, mg)
+	if err == nil && observation.ResourceExists && !observation.ResourceUpToDate && strings.TrimSpace(observation.Diff) != "" {
+		e.record.Event(mg, event.Normal(reasonDrift, msgDrift+truncate(observation.Diff)))
+	}
+	return observation, err
+}
+
+// truncate shortens the diff to the maximum length of an event.
+func truncate(diff string) string {
+	diff = strings.TrimSpace(diff)
+	if len(diff) <= maxDiffLength {
+		return diff
+	}
+	return diff[:maxDiffLength] + msgTruncated
+}
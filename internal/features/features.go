@@ -29,4 +29,9 @@ const (
 	// Management Policies. See the below design for more details.
 	// https://github.com/crossplane/crossplane/blob/master/design/design-doc-observe-only-resources.md
 	EnableAlphaManagementPolicies feature.Flag = "EnableAlphaManagementPolicies"
+
+	// EnableOrphanedNamespaceReport enables a controller, which periodically
+	// reports the namespaces of each ProviderConfig, which are not managed by
+	// any TemporalNamespace.
+	EnableOrphanedNamespaceReport feature.Flag = "EnableOrphanedNamespaceReport"
 )
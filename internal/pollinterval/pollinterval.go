@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pollinterval allows to override the poll interval of individual
+// managed resources and spreads their polls by a jitter.
+package pollinterval
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/internal/asyncoperation"
+)
+
+// AnnotationKey is the annotation, which overrides the poll interval of a
+// managed resource, e.g. `temporal.crossplane.io/poll-interval: 10m`.
+const AnnotationKey = "temporal.crossplane.io/poll-interval"
+
+// minPollInterval protects the Temporal server from managed resources, which
+// are annotated with a very short poll interval.
+const minPollInterval = 10 * time.Second
+
+// jitter is the maximum duration, which is randomly added to or subtracted
+// from each poll interval, so that managed resources, which were applied at
+// once, are not polled at once forever.
+var jitter time.Duration
+
+// SetJitter sets the jitter of the poll intervals. It must be called before
+// the controllers are started.
+func SetJitter(d time.Duration) {
+	jitter = d
+}
+
+// Hook returns the poll interval of the annotation of the managed resource
+// with jitter. Managed resources without or with an invalid annotation are
+// polled with the poll interval of the provider. Managed resources with a
+// running asynchronous operation are polled with the shorter poll interval of
+// the operation.
+func Hook(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	return withJitter(asyncoperation.Requeue(mg, annotatedInterval(mg, pollInterval)))
+}
+
+func annotatedInterval(mg resource.Managed, pollInterval time.Duration) time.Duration {
+	value, ok := mg.GetAnnotations()[AnnotationKey]
+	if !ok {
+		return pollInterval
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		return pollInterval
+	}
+
+	if interval < minPollInterval {
+		return minPollInterval
+	}
+	return interval
+}
+
+// withJitter adds a random duration between -jitter and +jitter to the
+// interval. The interval is kept, if the jitter is not shorter.
+func withJitter(interval time.Duration) time.Duration {
+	if jitter <= 0 || jitter >= interval {
+		return interval
+	}
+	return interval + time.Duration((rand.Float64()-0.5)*2*float64(jitter)) //#nosec G404 -- no need for secure randomness
+}
+
+// WithHook returns the reconciler option, which applies Hook.
+func WithHook() managed.ReconcilerOption {
+	return managed.WithPollIntervalHook(Hook)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providerref migrates the deprecated spec.providerRef of managed
+// resources to spec.providerConfigRef.
+package providerref
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// DefaultName is the name of the ProviderConfig, which the CRDs set as
+	// default for spec.providerConfigRef.
+	DefaultName = "default"
+
+	errUpdate = "cannot update managed resource with migrated providerConfigRef"
+)
+
+// A Referencer is a managed resource, which still supports the deprecated
+// spec.providerRef.
+type Referencer interface {
+	resource.Managed
+
+	GetProviderReference() *xpv1.Reference
+	SetProviderReference(r *xpv1.Reference)
+}
+
+// Migrate moves a set provider reference to the provider config reference,
+// unless the provider config reference was explicitly set to a non default
+// ProviderConfig. It returns true if the managed resource was changed.
+func Migrate(mg Referencer) bool {
+	ref := mg.GetProviderReference()
+	if ref == nil {
+		return false
+	}
+
+	if pcRef := mg.GetProviderConfigReference(); pcRef == nil || pcRef.Name == DefaultName {
+		mg.SetProviderConfigReference(ref.DeepCopy())
+	}
+	mg.SetProviderReference(nil)
+	return true
+}
+
+// NewInitializer returns an initializer, which migrates and persists the
+// deprecated provider reference of already existing managed resources.
+func NewInitializer(kube client.Client) managed.Initializer {
+	return managed.InitializerFn(func(ctx context.Context, mg resource.Managed) error {
+		ref, ok := mg.(Referencer)
+		if !ok || !Migrate(ref) {
+			return nil
+		}
+		return errors.Wrap(kube.Update(ctx, mg), errUpdate)
+	})
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit configures the backoff, with which the controllers retry
+// failed reconciles of a resource.
+package ratelimit
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+)
+
+var (
+	// baseDelay is the delay after the first failed reconcile of a resource.
+	// It doubles with each further failed reconcile.
+	baseDelay = 1 * time.Second
+
+	// maxDelay limits the delay of failed reconciles of a resource.
+	maxDelay = 60 * time.Second
+)
+
+// SetBackoff sets the base and the maximum delay of failed reconciles. It must
+// be called before the controllers are started.
+func SetBackoff(base time.Duration, max time.Duration) {
+	baseDelay = base
+	maxDelay = max
+}
+
+// ForControllerRuntime returns the controller-runtime options of the
+// controller options, whose rate limiter retries failed reconciles of a
+// resource with the configured backoff.
+func ForControllerRuntime(o controller.Options) ctrlcontroller.Options {
+	opts := o.ForControllerRuntime()
+	opts.RateLimiter = workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDelay)
+	return opts
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package requestinfo adds the gRPC code and the request id of requests, which
+// the Temporal server or the Temporal Cloud Operations API rejected, to the
+// errors of external clients. The reconciler reports these errors in events
+// and conditions, so that failures of the provider can be correlated with the
+// logs of the server.
+package requestinfo
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+// NewConnector returns a connector, whose external clients add the gRPC code
+// and the request id to the errors of the external clients of the passed
+// connector.
+func NewConnector(c managed.ExternalConnectDisconnecter) managed.ExternalConnectDisconnecter {
+	return &connector{ExternalConnectDisconnecter: c}
+}
+
+// requestError is an error of a request, whose message includes the gRPC code
+// and the request id of the request.
+type requestError struct {
+	err       error
+	code      codes.Code
+	requestId string
+}
+
+func (e *requestError) Error() string {
+	details := ""
+	if e.code != codes.OK {
+		details = "gRPC code: " + e.code.String()
+	}
+	if e.requestId != "" {
+		if details != "" {
+			details += ", "
+		}
+		details += "request id: " + e.requestId
+	}
+	return e.err.Error() + " (" + details + ")"
+}
+
+func (e *requestError) Unwrap() error {
+	return e.err
+}
+
+// Wrap adds the gRPC code and the request id to the message of the error, if
+// it was returned by the server. Other errors are returned unchanged.
+func Wrap(err error) error {
+	var wrapped *requestError
+	if err == nil || errors.As(err, &wrapped) {
+		return err
+	}
+
+	code, requestId, ok := temporal.RequestDetails(err)
+	if !ok {
+		return err
+	}
+	return &requestError{err: err, code: code, requestId: requestId}
+}
+
+type connector struct {
+	managed.ExternalConnectDisconnecter
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	client, err := c.ExternalConnectDisconnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, Wrap(err)
+	}
+	return &external{client: client}, nil
+}
+
+type external struct {
+	client managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	observation, err := e.client.Observe(ctx, mg)
+	return observation, Wrap(err)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	creation, err := e.client.Create(ctx, mg)
+	return creation, Wrap(err)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	update, err := e.client.Update(ctx, mg)
+	return update, Wrap(err)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return Wrap(e.client.Delete(ctx, mg))
+}
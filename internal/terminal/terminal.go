@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package terminal stops retrying requests, which the Temporal server rejected
+// with a terminal error, e.g. PermissionDenied or InvalidArgument. Such
+// requests are not sent again until the spec of the managed resource changes
+// or its reconciliation is resumed after a pause. The reconcile still fails
+// with the error, but it is requeued after the poll interval instead of being
+// retried endlessly with backoff.
+package terminal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	temporal "github.com/denniskniep/provider-temporal/internal/clients"
+)
+
+const (
+	errTerminal = "request was rejected and is not retried until the spec changes: %s"
+
+	// ReasonTerminalError indicates that the Temporal server rejected a
+	// request of the managed resource with a terminal error.
+	ReasonTerminalError xpv1.ConditionReason = "TerminalError"
+)
+
+// failure is a terminal error of a create or update request of a generation
+// of a managed resource.
+type failure struct {
+	generation int64
+	err        error
+}
+
+// A reasonedError is a terminal error, which reports the reason of the Ready
+// condition of the rejected managed resource itself, e.g. QuotaExceeded.
+type reasonedError interface {
+	Reason() xpv1.ConditionReason
+}
+
+// A Tracker tracks the terminal errors of the managed resources of a
+// controller.
+type Tracker struct {
+	// failures of create and update requests by the uid of the managed
+	// resource. They are kept in memory, therefore a failed request is retried
+	// once after the provider restarted.
+	failures sync.Map
+
+	// rejected are the names of the managed resources, whose current
+	// reconcile failed with a terminal error.
+	rejected sync.Map
+}
+
+// NewTracker returns a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// NewConnector returns a connector, whose external clients handle terminal
+// errors of the external clients of the passed connector.
+func (t *Tracker) NewConnector(c managed.ExternalConnectDisconnecter) managed.ExternalConnectDisconnecter {
+	return &connector{ExternalConnectDisconnecter: c, tracker: t}
+}
+
+// NewReconciler returns a reconciler, which requeues a managed resource after
+// the poll interval instead of with backoff, if its reconcile failed with a
+// terminal error. Changes of its spec still trigger a reconcile at once.
+func (t *Tracker) NewReconciler(r reconcile.Reconciler, pollInterval time.Duration) reconcile.Reconciler {
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		t.rejected.Delete(req.Name)
+		result, err := r.Reconcile(ctx, req)
+		if _, rejected := t.rejected.LoadAndDelete(req.Name); rejected && err == nil {
+			return reconcile.Result{RequeueAfter: pollInterval}, nil
+		}
+		return result, err
+	})
+}
+
+// reject reports the terminal error in the Ready condition of the managed
+// resource and marks its reconcile as rejected.
+func (t *Tracker) reject(mg resource.Managed, err error) {
+	condition := xpv1.Unavailable().WithMessage(errors.Errorf(errTerminal, err).Error())
+	condition.Reason = ReasonTerminalError
+
+	var reasoned reasonedError
+	if errors.As(err, &reasoned) {
+		condition = xpv1.Unavailable().WithMessage(err.Error())
+		condition.Reason = reasoned.Reason()
+	}
+
+	mg.SetConditions(condition)
+	t.rejected.Store(mg.GetName(), true)
+}
+
+type connector struct {
+	managed.ExternalConnectDisconnecter
+	tracker *Tracker
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	client, err := c.ExternalConnectDisconnecter.Connect(ctx, mg)
+	if err != nil {
+		if temporal.IsTerminalError(err) && !meta.WasDeleted(mg) {
+			c.tracker.reject(mg, err)
+		}
+		return nil, err
+	}
+	return &external{client: client, tracker: c.tracker}, nil
+}
+
+type external struct {
+	client  managed.ExternalClient
+	tracker *Tracker
+}
+
+// Observe fails with a terminal error, if the managed resource can not be
+// observed because of a terminal error or if a create or update request of
+// its current generation failed with a terminal error, so that the request is
+// not sent again. Deleted managed resources are always retried, so that their
+// finalizer is not removed too early, unless they have no external name.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	// The external resource of a deleted managed resource without external
+	// name was never created, therefore its finalizer can be removed.
+	if meta.WasDeleted(mg) && meta.GetExternalName(mg) == "" {
+		e.tracker.failures.Delete(mg.GetUID())
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// A paused managed resource is not observed, therefore the first observe
+	// after it was resumed still sees the paused condition. Resuming retries
+	// failed requests, e.g. after the permissions were fixed in the meantime.
+	if mg.GetCondition(xpv1.TypeSynced).Reason == xpv1.ReasonReconcilePaused {
+		e.tracker.failures.Delete(mg.GetUID())
+	}
+
+	observation, err := e.client.Observe(ctx, mg)
+	if meta.WasDeleted(mg) {
+		return observation, err
+	}
+
+	if err != nil {
+		if temporal.IsTerminalError(err) {
+			e.tracker.reject(mg, err)
+		}
+		return observation, err
+	}
+
+	value, ok := e.tracker.failures.Load(mg.GetUID())
+	if !ok {
+		return observation, nil
+	}
+
+	f := value.(failure)
+	if f.generation != mg.GetGeneration() || (observation.ResourceExists && observation.ResourceUpToDate) {
+		e.tracker.failures.Delete(mg.GetUID())
+		return observation, nil
+	}
+
+	e.tracker.reject(mg, f.err)
+	return observation, errors.Errorf(errTerminal, f.err)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	creation, err := e.client.Create(ctx, mg)
+	e.recordFailure(mg, err)
+	return creation, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	update, err := e.client.Update(ctx, mg)
+	e.recordFailure(mg, err)
+	return update, err
+}
+
+// Delete skips managed resources without external name, because their
+// external resource was never created, e.g. because its create was rejected.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if meta.GetExternalName(mg) == "" {
+		e.tracker.failures.Delete(mg.GetUID())
+		return nil
+	}
+
+	err := e.client.Delete(ctx, mg)
+	if err == nil {
+		e.tracker.failures.Delete(mg.GetUID())
+	}
+	return err
+}
+
+// recordFailure records a terminal error, so that the request is not sent
+// again until the generation of the managed resource changes. The error
+// itself is still returned, so that it is reported by the reconciler.
+func (e *external) recordFailure(mg resource.Managed, err error) {
+	if err == nil || !temporal.IsTerminalError(err) {
+		return
+	}
+
+	e.tracker.failures.Store(mg.GetUID(), failure{generation: mg.GetGeneration(), err: err})
+	e.tracker.reject(mg, err)
+}
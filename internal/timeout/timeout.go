@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package timeout limits the duration of each operation of the external
+// clients, so that a slow Temporal call does not occupy a reconcile worker
+// until the reconcile times out, and the duration of the whole reconcile.
+package timeout
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errSyncTimeout = "sync timeout of %s exceeded, the sync is retried"
+
+// operationTimeout is the timeout of each Connect, Observe, Create, Update
+// and Delete operation. Operations are not limited, if it is not positive.
+var operationTimeout time.Duration
+
+// syncTimeout is the timeout of a reconcile, i.e. of all its operations
+// together. The timeout of crossplane-runtime applies, if it is not positive.
+var syncTimeout time.Duration
+
+// SetOperationTimeout sets the timeout of the operations. It must be called
+// before the controllers are started.
+func SetOperationTimeout(d time.Duration) {
+	operationTimeout = d
+}
+
+// SetSyncTimeout sets the timeout of the reconciles. It must be called before
+// the controllers are started.
+func SetSyncTimeout(d time.Duration) {
+	syncTimeout = d
+}
+
+// WithSyncTimeout returns the reconciler option, which limits the external
+// operations of a reconcile to the sync timeout. A reconcile, which exceeds
+// it, fails with an error and is retried with backoff.
+func WithSyncTimeout() managed.ReconcilerOption {
+	return func(r *managed.Reconciler) {
+		if syncTimeout > 0 {
+			managed.WithTimeout(syncTimeout)(r)
+		}
+	}
+}
+
+// NewConnector returns a connector, whose operations and the operations of
+// its external clients are limited by the operation timeout.
+func NewConnector(c managed.ExternalConnectDisconnecter) managed.ExternalConnectDisconnecter {
+	return &connector{ExternalConnectDisconnecter: c}
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if operationTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, operationTimeout)
+}
+
+// syncTimedOut explains the error of an operation, which was canceled,
+// because the reconcile exceeded the sync timeout. The context is the context
+// of the reconcile, not the context of the operation.
+func syncTimedOut(ctx context.Context, err error) error {
+	if err == nil || syncTimeout <= 0 || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	return errors.Wrapf(err, errSyncTimeout, syncTimeout)
+}
+
+type connector struct {
+	managed.ExternalConnectDisconnecter
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	opCtx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	client, err := c.ExternalConnectDisconnecter.Connect(opCtx, mg)
+	if err != nil {
+		return nil, syncTimedOut(ctx, err)
+	}
+	return &external{client: client}, nil
+}
+
+type external struct {
+	client managed.ExternalClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	opCtx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	observation, err := e.client.Observe(opCtx, mg)
+	return observation, syncTimedOut(ctx, err)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	opCtx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	creation, err := e.client.Create(opCtx, mg)
+	return creation, syncTimedOut(ctx, err)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	opCtx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	update, err := e.client.Update(opCtx, mg)
+	return update, syncTimedOut(ctx, err)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	opCtx, cancel := withTimeout(ctx)
+	defer cancel()
+	return syncTimedOut(ctx, e.client.Delete(opCtx, mg))
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package usage manages the lifecycle of ProviderConfigUsages.
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+const (
+	// managedFinalizerName is the finalizer, which the managed reconciler of
+	// crossplane-runtime adds by default.
+	managedFinalizerName = "finalizer.managedresource.crossplane.io"
+
+	// gcInterval is the interval in which stale ProviderConfigUsages are
+	// garbage collected.
+	gcInterval = 10 * time.Minute
+
+	errDeleteUsage = "cannot delete ProviderConfigUsage"
+	errListUsages  = "cannot list ProviderConfigUsages"
+	errGetResource = "cannot get resource of ProviderConfigUsage"
+)
+
+// A Finalizer removes the ProviderConfigUsage of a managed resource, before
+// it removes the finalizer of the managed resource.
+type Finalizer struct {
+	resource.Finalizer
+	kube client.Client
+}
+
+// NewFinalizer returns a Finalizer, which removes the ProviderConfigUsage of
+// a managed resource, once the managed resource is deleted.
+func NewFinalizer(kube client.Client) *Finalizer {
+	return &Finalizer{
+		Finalizer: resource.NewAPIFinalizer(kube, managedFinalizerName),
+		kube:      kube,
+	}
+}
+
+// RemoveFinalizer deletes the ProviderConfigUsage of the supplied managed
+// resource and removes its finalizer afterwards.
+func (f *Finalizer) RemoveFinalizer(ctx context.Context, obj resource.Object) error {
+	pcu := &v1alpha1.ProviderConfigUsage{}
+	pcu.SetName(string(obj.GetUID()))
+	if err := f.kube.Delete(ctx, pcu); resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errDeleteUsage)
+	}
+	return f.Finalizer.RemoveFinalizer(ctx, obj)
+}
+
+// A GarbageCollector periodically deletes ProviderConfigUsages, whose managed
+// resource does not exist anymore.
+type GarbageCollector struct {
+	kube     client.Client
+	logger   logging.Logger
+	interval time.Duration
+}
+
+// SetupGarbageCollector adds a GarbageCollector to the supplied manager.
+func SetupGarbageCollector(mgr ctrl.Manager, o controller.Options) error {
+	return mgr.Add(&GarbageCollector{
+		kube:     mgr.GetClient(),
+		logger:   o.Logger.WithValues("runnable", "providerconfigusage-gc"),
+		interval: gcInterval,
+	})
+}
+
+// Start collects stale ProviderConfigUsages in the configured interval, until
+// the supplied context is done.
+func (gc *GarbageCollector) Start(ctx context.Context) error {
+	t := time.NewTicker(gc.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := gc.Collect(ctx); err != nil {
+				gc.logger.Info("Cannot garbage collect ProviderConfigUsages", "error", err)
+			}
+		}
+	}
+}
+
+// Collect deletes all ProviderConfigUsages, whose managed resource does not
+// exist anymore or was recreated with a different UID.
+func (gc *GarbageCollector) Collect(ctx context.Context) error {
+	l := &v1alpha1.ProviderConfigUsageList{}
+	if err := gc.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListUsages)
+	}
+
+	for i := range l.Items {
+		pcu := &l.Items[i]
+		stale, err := gc.isStale(ctx, pcu)
+		if err != nil {
+			return err
+		}
+		if !stale {
+			continue
+		}
+
+		gc.logger.Debug("Delete stale ProviderConfigUsage '" + pcu.Name + "' of " + pcu.ResourceReference.Kind + " '" + pcu.ResourceReference.Name + "'")
+		if err := gc.kube.Delete(ctx, pcu); resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, errDeleteUsage)
+		}
+	}
+	return nil
+}
+
+func (gc *GarbageCollector) isStale(ctx context.Context, pcu *v1alpha1.ProviderConfigUsage) (bool, error) {
+	ref := pcu.ResourceReference
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false, errors.Wrap(err, errGetResource)
+	}
+
+	mg := &unstructured.Unstructured{}
+	mg.SetGroupVersionKind(gv.WithKind(ref.Kind))
+	err = gc.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, mg)
+	if kerrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetResource)
+	}
+
+	// ProviderConfigUsages are named after the UID of their managed resource.
+	return string(mg.GetUID()) != pcu.Name, nil
+}
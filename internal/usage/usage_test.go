@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/denniskniep/provider-temporal/apis/v1alpha1"
+)
+
+func newManaged(uid string) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetName("test")
+	mg.SetUID(types.UID(uid))
+	meta.AddFinalizer(mg, managedFinalizerName)
+	return mg
+}
+
+func TestRemoveFinalizerDeletesUsage(t *testing.T) {
+	cases := map[string]struct {
+		deleteErr     error
+		wantErr       bool
+		wantFinalizer bool
+	}{
+		"Deleted":      {},
+		"NotFound":     {deleteErr: kerrors.NewNotFound(schema.GroupResource{}, "uid-1")},
+		"DeleteFailed": {deleteErr: errors.New("boom"), wantErr: true, wantFinalizer: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			updated := false
+			kube := &test.MockClient{
+				MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+					deleted = append(deleted, obj.GetName())
+					return tc.deleteErr
+				},
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					updated = true
+					return nil
+				},
+			}
+
+			mg := newManaged("uid-1")
+			err := NewFinalizer(kube).RemoveFinalizer(context.Background(), mg)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error %v, got %v", tc.wantErr, err)
+			}
+
+			if len(deleted) != 1 || deleted[0] != "uid-1" {
+				t.Fatalf("Expected ProviderConfigUsage uid-1 to be deleted, got %v", deleted)
+			}
+
+			hasFinalizer := meta.FinalizerExists(mg, managedFinalizerName)
+			if hasFinalizer != tc.wantFinalizer || updated == tc.wantFinalizer {
+				t.Fatalf("Expected finalizer %v, got finalizer %v and update %v", tc.wantFinalizer, hasFinalizer, updated)
+			}
+		})
+	}
+}
+
+func TestAddFinalizer(t *testing.T) {
+	kube := &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+
+	mg := &fake.Managed{}
+	if err := NewFinalizer(kube).AddFinalizer(context.Background(), mg); err != nil {
+		t.Fatal(err)
+	}
+
+	if !meta.FinalizerExists(mg, managedFinalizerName) {
+		t.Fatal("Expected finalizer of the managed reconciler")
+	}
+}
+
+func newUsage(uid string, resourceName string) v1alpha1.ProviderConfigUsage {
+	pcu := v1alpha1.ProviderConfigUsage{}
+	pcu.SetName(uid)
+	pcu.ResourceReference = xpv1.TypedReference{
+		APIVersion: "core.temporal.crossplane.io/v1alpha1",
+		Kind:       "TemporalNamespace",
+		Name:       resourceName,
+	}
+	return pcu
+}
+
+func TestCollectDeletesStaleUsages(t *testing.T) {
+	// The managed resources by name with their UID
+	resources := map[string]string{
+		"current":   "uid-current",
+		"recreated": "uid-new",
+	}
+
+	var deleted []string
+	kube := &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1alpha1.ProviderConfigUsageList).Items = []v1alpha1.ProviderConfigUsage{
+				newUsage("uid-current", "current"),
+				newUsage("uid-old", "recreated"),
+				newUsage("uid-deleted", "deleted"),
+			}
+			return nil
+		},
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			mg := obj.(*unstructured.Unstructured)
+			if mg.GetKind() != "TemporalNamespace" {
+				t.Fatalf("Expected TemporalNamespace to be read, got %s", mg.GetKind())
+			}
+
+			uid, ok := resources[key.Name]
+			if !ok {
+				return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+			}
+			mg.SetUID(types.UID(uid))
+			return nil
+		},
+		MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+			deleted = append(deleted, obj.GetName())
+			return nil
+		},
+	}
+
+	gc := &GarbageCollector{kube: kube, logger: logging.NewNopLogger()}
+	if err := gc.Collect(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deleted) != 2 || deleted[0] != "uid-old" || deleted[1] != "uid-deleted" {
+		t.Fatalf("Expected stale ProviderConfigUsages uid-old and uid-deleted to be deleted, got %v", deleted)
+	}
+}
+
+func TestCollectKeepsUsagesIfResourceCannotBeRead(t *testing.T) {
+	deleted := 0
+	kube := &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1alpha1.ProviderConfigUsageList).Items = []v1alpha1.ProviderConfigUsage{newUsage("uid-1", "test")}
+			return nil
+		},
+		MockGet: test.NewMockGetFn(errors.New("boom")),
+		MockDelete: func(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+			deleted++
+			return nil
+		},
+	}
+
+	gc := &GarbageCollector{kube: kube, logger: logging.NewNopLogger()}
+	if err := gc.Collect(context.Background()); err == nil {
+		t.Fatal("Expected error, if the managed resource cannot be read")
+	}
+
+	if deleted != 0 {
+		t.Fatalf("Expected no ProviderConfigUsage to be deleted, got %d", deleted)
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+const (
+	defaultAccountRole   = "Read"
+	defaultPermission    = "Read"
+	defaultRetentionDays = 30
+	defaultAuthMethod    = "ApiKey"
+
+	errNamespaceId  = "must be the id of a namespace, i.e. its name with the account id suffix, e.g. test.acct"
+	errExpiryTime   = "must be in the future"
+	errEmail        = "must be an email address"
+	errBlank        = "must not be blank"
+	errKmsArnRegion = "must be a KMS key of the region %s"
+	errKmsArn       = "must be the ARN of a KMS key, e.g. arn:aws:kms:us-east-1:123456789012:key/..."
+)
+
+var (
+	namespaceIdPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*\.[a-z0-9]+$`)
+	kmsArnPattern      = regexp.MustCompile(`^arn:aws[a-z-]*:kms:([a-z0-9-]+):\d{12}:key/.+$`)
+)
+
+func validateCloudApiKey(obj runtime.Object, old runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudApiKey)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	// The expiry time is immutable, therefore it is only checked on create
+	if old != nil {
+		return nil, nil
+	}
+
+	expiryTime := cr.Spec.ForProvider.ExpiryTime
+	if !expiryTime.Time.After(time.Now()) {
+		return nil, field.ErrorList{field.Invalid(forProvider.Child("expiryTime"), expiryTime.Format(time.RFC3339), errExpiryTime)}
+	}
+	return nil, nil
+}
+
+func validateCloudMetricsEndpoint(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudMetricsEndpoint)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	return nil, validateCertificates(forProvider.Child("acceptedClientCa"), cr.Spec.ForProvider.AcceptedClientCa)
+}
+
+func validateCloudNamespace(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudNamespace)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateUnique(forProvider.Child("regions"), parameters.Regions)
+
+	if parameters.MtlsAuth != nil {
+		errs = append(errs, validateCertificates(forProvider.Child("mtlsAuth", "acceptedClientCa"), parameters.MtlsAuth.AcceptedClientCa)...)
+	}
+	return nil, errs
+}
+
+func validateCloudNamespaceAccess(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudNamespaceAccess)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	return nil, validateMatches(forProvider.Child("namespace"), cr.Spec.ForProvider.Namespace, namespaceIdPattern, errNamespaceId)
+}
+
+func validateCloudNamespaceExportSink(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudNamespaceExportSink)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateMatches(forProvider.Child("namespace"), parameters.Namespace, namespaceIdPattern, errNamespaceId)
+
+	if s3 := parameters.S3; s3 != nil && s3.KmsArn != "" {
+		path := forProvider.Child("s3", "kmsArn")
+		match := kmsArnPattern.FindStringSubmatch(s3.KmsArn)
+		switch {
+		case match == nil:
+			errs = append(errs, field.Invalid(path, s3.KmsArn, errKmsArn))
+		case match[1] != s3.Region:
+			errs = append(errs, field.Invalid(path, s3.KmsArn, errors.Errorf(errKmsArnRegion, s3.Region).Error()))
+		}
+	}
+	return nil, errs
+}
+
+func validateCloudNexusEndpoint(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudNexusEndpoint)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateMatches(forProvider.Child("targetNamespace"), parameters.TargetNamespace, namespaceIdPattern, errNamespaceId)
+
+	path := forProvider.Child("allowedCallerNamespaces")
+	for i := range parameters.AllowedCallerNamespaces {
+		errs = append(errs, validateMatches(path.Index(i), &parameters.AllowedCallerNamespaces[i], namespaceIdPattern, errNamespaceId)...)
+	}
+	errs = append(errs, validateUnique(path, parameters.AllowedCallerNamespaces)...)
+
+	return nil, errs
+}
+
+func validateCloudServiceAccount(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudServiceAccount)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	if strings.TrimSpace(cr.Spec.ForProvider.Name) == "" {
+		return nil, field.ErrorList{field.Invalid(forProvider.Child("name"), cr.Spec.ForProvider.Name, errBlank)}
+	}
+	return nil, nil
+}
+
+func validateCloudUser(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudUser)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	email := cr.Spec.ForProvider.Email
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return nil, field.ErrorList{field.Invalid(forProvider.Child("email"), email, errEmail)}
+	}
+	return nil, nil
+}
+
+func validateCloudUserGroup(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.CloudUserGroup)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateUnique(forProvider.Child("memberUserIds"), parameters.MemberUserIds)
+
+	if strings.TrimSpace(parameters.DisplayName) == "" {
+		errs = append(errs, field.Invalid(forProvider.Child("displayName"), parameters.DisplayName, errBlank))
+	}
+	return nil, errs
+}
+
+func defaultCloudNamespace(obj runtime.Object) {
+	cr, ok := obj.(*v1alpha1.CloudNamespace)
+	if !ok {
+		return
+	}
+
+	defaultString(&cr.Spec.ForProvider.AuthMethod, defaultAuthMethod)
+	if cr.Spec.ForProvider.RetentionDays == 0 {
+		cr.Spec.ForProvider.RetentionDays = defaultRetentionDays
+	}
+}
+
+func defaultCloudNamespaceAccess(obj runtime.Object) {
+	if cr, ok := obj.(*v1alpha1.CloudNamespaceAccess); ok {
+		defaultString(&cr.Spec.ForProvider.Permission, defaultPermission)
+	}
+}
+
+func defaultCloudServiceAccount(obj runtime.Object) {
+	if cr, ok := obj.(*v1alpha1.CloudServiceAccount); ok {
+		defaultString(&cr.Spec.ForProvider.AccountRole, defaultAccountRole)
+	}
+}
+
+func defaultCloudUser(obj runtime.Object) {
+	if cr, ok := obj.(*v1alpha1.CloudUser); ok {
+		defaultString(&cr.Spec.ForProvider.AccountRole, defaultAccountRole)
+	}
+}
+
+func defaultCloudUserGroup(obj runtime.Object) {
+	if cr, ok := obj.(*v1alpha1.CloudUserGroup); ok {
+		defaultString(&cr.Spec.ForProvider.AccountRole, defaultAccountRole)
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+)
+
+// testCertificate returns a PEM encoded self-signed CA certificate.
+func testCertificate(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestValidateCloudApiKey(t *testing.T) {
+	apiKey := func(expiryTime time.Time) *v1alpha1.CloudApiKey {
+		cr := &v1alpha1.CloudApiKey{}
+		cr.Spec.ForProvider.ExpiryTime = metav1.NewTime(expiryTime)
+		return cr
+	}
+
+	expectErrors(t, validateCloudApiKey, apiKey(time.Now().Add(24*time.Hour)), nil, 0, 0)
+	expectErrors(t, validateCloudApiKey, apiKey(time.Now().Add(-24*time.Hour)), nil, 1, 0)
+	expectErrors(t, validateCloudApiKey, apiKey(time.Now().Add(-24*time.Hour)), apiKey(time.Now().Add(-24*time.Hour)), 0, 0)
+}
+
+func TestValidateCloudMetricsEndpoint(t *testing.T) {
+	metricsEndpoint := func(ca string) *v1alpha1.CloudMetricsEndpoint {
+		cr := &v1alpha1.CloudMetricsEndpoint{}
+		cr.Spec.ForProvider.AcceptedClientCa = ca
+		return cr
+	}
+
+	certificate := testCertificate(t)
+	expectErrors(t, validateCloudMetricsEndpoint, metricsEndpoint(certificate), nil, 0, 0)
+	expectErrors(t, validateCloudMetricsEndpoint, metricsEndpoint(certificate+certificate), nil, 0, 0)
+	expectErrors(t, validateCloudMetricsEndpoint, metricsEndpoint(""), nil, 0, 0)
+	expectErrors(t, validateCloudMetricsEndpoint, metricsEndpoint("not a certificate"), nil, 1, 0)
+	expectErrors(t, validateCloudMetricsEndpoint, metricsEndpoint("-----BEGIN CERTIFICATE-----\nYWJj\n-----END CERTIFICATE-----\n"), nil, 1, 0)
+}
+
+func TestValidateCloudNamespace(t *testing.T) {
+	namespace := func(ca string, regions ...string) *v1alpha1.CloudNamespace {
+		cr := &v1alpha1.CloudNamespace{}
+		cr.Spec.ForProvider.Regions = regions
+		cr.Spec.ForProvider.MtlsAuth = &v1alpha1.CloudNamespaceMtlsAuth{AcceptedClientCa: ca}
+		return cr
+	}
+
+	expectErrors(t, validateCloudNamespace, namespace(testCertificate(t), "aws-us-east-1", "aws-us-west-2"), nil, 0, 0)
+	expectErrors(t, validateCloudNamespace, namespace("", "aws-us-east-1", "aws-us-east-1"), nil, 1, 0)
+	expectErrors(t, validateCloudNamespace, namespace("not a certificate", "aws-us-east-1"), nil, 1, 0)
+}
+
+func TestValidateCloudNamespaceAccess(t *testing.T) {
+	access := func(namespace *string) *v1alpha1.CloudNamespaceAccess {
+		cr := &v1alpha1.CloudNamespaceAccess{}
+		cr.Spec.ForProvider.Namespace = namespace
+		return cr
+	}
+
+	expectErrors(t, validateCloudNamespaceAccess, access(ptr("test001.acct")), nil, 0, 0)
+	expectErrors(t, validateCloudNamespaceAccess, access(nil), nil, 0, 0)
+	expectErrors(t, validateCloudNamespaceAccess, access(ptr("test001")), nil, 1, 0)
+}
+
+func TestValidateCloudNamespaceExportSink(t *testing.T) {
+	exportSink := func(region string, kmsArn string) *v1alpha1.CloudNamespaceExportSink {
+		cr := &v1alpha1.CloudNamespaceExportSink{}
+		cr.Spec.ForProvider.Namespace = ptr("test001.acct")
+		cr.Spec.ForProvider.S3 = &v1alpha1.CloudExportSinkS3{Region: region, KmsArn: kmsArn}
+		return cr
+	}
+
+	expectErrors(t, validateCloudNamespaceExportSink, exportSink("us-east-1", ""), nil, 0, 0)
+	expectErrors(t, validateCloudNamespaceExportSink, exportSink("us-east-1", "arn:aws:kms:us-east-1:123456789012:key/1234abcd"), nil, 0, 0)
+	expectErrors(t, validateCloudNamespaceExportSink, exportSink("us-east-1", "arn:aws:kms:eu-west-1:123456789012:key/1234abcd"), nil, 1, 0)
+	expectErrors(t, validateCloudNamespaceExportSink, exportSink("us-east-1", "1234abcd"), nil, 1, 0)
+}
+
+func TestValidateCloudNexusEndpoint(t *testing.T) {
+	nexusEndpoint := func(target string, callers ...string) *v1alpha1.CloudNexusEndpoint {
+		cr := &v1alpha1.CloudNexusEndpoint{}
+		cr.Spec.ForProvider.TargetNamespace = ptr(target)
+		cr.Spec.ForProvider.AllowedCallerNamespaces = callers
+		return cr
+	}
+
+	expectErrors(t, validateCloudNexusEndpoint, nexusEndpoint("target.acct", "caller1.acct", "caller2.acct"), nil, 0, 0)
+	expectErrors(t, validateCloudNexusEndpoint, nexusEndpoint("target", "caller1.acct"), nil, 1, 0)
+	expectErrors(t, validateCloudNexusEndpoint, nexusEndpoint("target.acct", "caller1.acct", "caller1.acct", "caller2"), nil, 2, 0)
+}
+
+func TestValidateCloudServiceAccount(t *testing.T) {
+	serviceAccount := func(name string) *v1alpha1.CloudServiceAccount {
+		cr := &v1alpha1.CloudServiceAccount{}
+		cr.Spec.ForProvider.Name = name
+		return cr
+	}
+
+	expectErrors(t, validateCloudServiceAccount, serviceAccount("ci"), nil, 0, 0)
+	expectErrors(t, validateCloudServiceAccount, serviceAccount("  "), nil, 1, 0)
+}
+
+func TestValidateCloudUser(t *testing.T) {
+	user := func(email string) *v1alpha1.CloudUser {
+		cr := &v1alpha1.CloudUser{}
+		cr.Spec.ForProvider.Email = email
+		return cr
+	}
+
+	expectErrors(t, validateCloudUser, user("jane@example.com"), nil, 0, 0)
+	expectErrors(t, validateCloudUser, user("Jane <jane@example.com>"), nil, 1, 0)
+	expectErrors(t, validateCloudUser, user("jane"), nil, 1, 0)
+}
+
+func TestValidateCloudUserGroup(t *testing.T) {
+	userGroup := func(displayName string, members ...string) *v1alpha1.CloudUserGroup {
+		cr := &v1alpha1.CloudUserGroup{}
+		cr.Spec.ForProvider.DisplayName = displayName
+		cr.Spec.ForProvider.MemberUserIds = members
+		return cr
+	}
+
+	expectErrors(t, validateCloudUserGroup, userGroup("admins", "user1", "user2"), nil, 0, 0)
+	expectErrors(t, validateCloudUserGroup, userGroup("admins", "user1", "user1"), nil, 1, 0)
+	expectErrors(t, validateCloudUserGroup, userGroup(""), nil, 1, 0)
+}
+
+func TestDefaultCloudNamespace(t *testing.T) {
+	cr := &v1alpha1.CloudNamespace{}
+	defaultCloudNamespace(cr)
+
+	if cr.Spec.ForProvider.AuthMethod != "ApiKey" || cr.Spec.ForProvider.RetentionDays != 30 {
+		t.Errorf("Expected defaults to be set, got %+v", cr.Spec.ForProvider)
+	}
+}
+
+func TestDefaultCloudAccountRole(t *testing.T) {
+	user := &v1alpha1.CloudUser{}
+	defaultCloudUser(user)
+
+	serviceAccount := &v1alpha1.CloudServiceAccount{}
+	defaultCloudServiceAccount(serviceAccount)
+
+	userGroup := &v1alpha1.CloudUserGroup{}
+	userGroup.Spec.ForProvider.AccountRole = "Admin"
+	defaultCloudUserGroup(userGroup)
+
+	access := &v1alpha1.CloudNamespaceAccess{}
+	defaultCloudNamespaceAccess(access)
+
+	if user.Spec.ForProvider.AccountRole != "Read" || serviceAccount.Spec.ForProvider.AccountRole != "Read" ||
+		userGroup.Spec.ForProvider.AccountRole != "Admin" || access.Spec.ForProvider.Permission != "Read" {
+		t.Error("Expected unset roles to default to Read and set roles to be kept")
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+const (
+	archivalStateDisabled = "Disabled"
+
+	defaultAdoptionPolicy    = "Adopt"
+	defaultDataMergeStrategy = "Replace"
+	defaultOverlapPolicy     = "Skip"
+	defaultCatchupWindow     = 365 * 24 * time.Hour
+
+	errNamespaceName    = "must consist of at most 1000 letters, digits, '.', '_' and '-' and start with a letter or digit"
+	errSearchAttribute  = "must start with a letter and consist of at most 255 letters, digits, '_' and '-'"
+	errReserved         = "is reserved by Temporal"
+	errArchivalScheme   = "must be a file, gs or s3 URI"
+	errArchivalFilePath = "must be an absolute path"
+	errArchivalBucket   = "must contain a bucket"
+	errTimezone         = "must be an IANA time zone name, e.g. Europe/Berlin"
+	errFrontendAddress  = "must be host:port"
+	errBuildIdInSets    = "must only be part of one version set"
+
+	warnArchivalUriUnused    = "%s is not used, because %s is Disabled"
+	warnArchivalUriImmutable = "%s can not be changed once it was set, the change is ignored"
+)
+
+var (
+	namespaceNamePattern   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,999}$`)
+	searchAttributePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]{0,254}$`)
+
+	// reservedSearchAttributes are the names of the system and predefined
+	// search attributes of Temporal, like in the CEL rules of the CRDs.
+	reservedSearchAttributes = map[string]bool{
+		"WorkflowId": true, "RunId": true, "WorkflowType": true, "StartTime": true, "ExecutionTime": true, "CloseTime": true,
+		"ExecutionStatus": true, "TaskQueue": true, "HistoryLength": true, "HistorySizeBytes": true, "ExecutionDuration": true,
+		"StateTransitionCount": true, "ParentWorkflowId": true, "ParentRunId": true, "RootWorkflowId": true, "RootRunId": true,
+		"NamespaceId": true, "Memo": true, "Encoding": true, "VisibilityTaskKey": true, "BatcherUser": true,
+		"BatcherNamespace": true, "BinaryChecksums": true, "BuildIds": true,
+	}
+)
+
+func validateTemporalNamespace(obj runtime.Object, old runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.TemporalNamespace)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	var warnings admission.Warnings
+	var errs field.ErrorList
+
+	archivals := []struct {
+		state    string
+		uri      *string
+		oldUri   *string
+		stateKey string
+		uriKey   string
+	}{
+		{parameters.HistoryArchivalState, parameters.HistoryArchivalUri, nil, "historyArchivalState", "historyArchivalUri"},
+		{parameters.VisibilityArchivalState, parameters.VisibilityArchivalUri, nil, "visibilityArchivalState", "visibilityArchivalUri"},
+	}
+
+	if oldCr, ok := old.(*v1alpha1.TemporalNamespace); ok {
+		archivals[0].oldUri = oldCr.Spec.ForProvider.HistoryArchivalUri
+		archivals[1].oldUri = oldCr.Spec.ForProvider.VisibilityArchivalUri
+	}
+
+	for _, archival := range archivals {
+		if archival.uri == nil || *archival.uri == "" {
+			continue
+		}
+
+		errs = append(errs, validateArchivalUri(forProvider.Child(archival.uriKey), *archival.uri)...)
+
+		if archival.state == archivalStateDisabled {
+			warnings = append(warnings, errors.Errorf(warnArchivalUriUnused, archival.uriKey, archival.stateKey).Error())
+		}
+
+		if archival.oldUri != nil && *archival.oldUri != "" && *archival.oldUri != *archival.uri {
+			warnings = append(warnings, errors.Errorf(warnArchivalUriImmutable, archival.uriKey).Error())
+		}
+	}
+
+	if parameters.CustomSearchAttributeAliases != nil {
+		aliases := *parameters.CustomSearchAttributeAliases
+		names := make([]string, 0, len(aliases))
+		for name := range aliases {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		seen := map[string]bool{}
+		for _, name := range names {
+			alias := aliases[name]
+			if seen[alias] {
+				errs = append(errs, field.Invalid(forProvider.Child("customSearchAttributeAliases").Key(name), alias, errNotUnique))
+			}
+			seen[alias] = true
+		}
+	}
+
+	return warnings, errs
+}
+
+// validateArchivalUri validates the URI like the archivers of Temporal do.
+func validateArchivalUri(path *field.Path, value string) field.ErrorList {
+	uri, err := url.Parse(value)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, value, err.Error())}
+	}
+
+	switch uri.Scheme {
+	case "file":
+		if !strings.HasPrefix(uri.Path, "/") {
+			return field.ErrorList{field.Invalid(path, value, errArchivalFilePath)}
+		}
+	case "gs", "s3":
+		if uri.Host == "" {
+			return field.ErrorList{field.Invalid(path, value, errArchivalBucket)}
+		}
+	default:
+		return field.ErrorList{field.Invalid(path, value, errArchivalScheme)}
+	}
+	return nil
+}
+
+func validateSearchAttribute(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.SearchAttribute)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateSearchAttributeName(forProvider.Child("name"), parameters.Name)
+	errs = append(errs, validateMatches(forProvider.Child("temporalNamespaceName"), parameters.TemporalNamespaceName, namespaceNamePattern, errNamespaceName)...)
+
+	path := forProvider.Child("temporalNamespaceNames")
+	for i := range parameters.TemporalNamespaceNames {
+		errs = append(errs, validateMatches(path.Index(i), &parameters.TemporalNamespaceNames[i], namespaceNamePattern, errNamespaceName)...)
+	}
+	errs = append(errs, validateUnique(path, parameters.TemporalNamespaceNames)...)
+
+	return nil, errs
+}
+
+func validateSearchAttributeSet(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.SearchAttributeSet)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateMatches(forProvider.Child("temporalNamespaceName"), parameters.TemporalNamespaceName, namespaceNamePattern, errNamespaceName)
+
+	names := make([]string, 0, len(parameters.SearchAttributes))
+	for name := range parameters.SearchAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		errs = append(errs, validateSearchAttributeName(forProvider.Child("searchAttributes").Key(name), name)...)
+	}
+
+	return nil, errs
+}
+
+// validateSearchAttributeName returns an error, if the name is set and is not
+// a valid name of a custom search attribute, like the CRDs do.
+func validateSearchAttributeName(path *field.Path, name string) field.ErrorList {
+	switch {
+	case name == "":
+		return nil
+	case !searchAttributePattern.MatchString(name):
+		return field.ErrorList{field.Invalid(path, name, errSearchAttribute)}
+	case reservedSearchAttributes[name] || strings.HasPrefix(name, "Temporal"):
+		return field.ErrorList{field.Invalid(path, name, errReserved)}
+	}
+	return nil
+}
+
+func validateSchedule(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.Schedule)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateMatches(forProvider.Child("temporalNamespaceName"), parameters.TemporalNamespaceName, namespaceNamePattern, errNamespaceName)
+
+	if timezone := parameters.Spec.TimezoneName; timezone != nil && *timezone != "" {
+		if _, err := time.LoadLocation(*timezone); err != nil {
+			errs = append(errs, field.Invalid(forProvider.Child("spec", "timezoneName"), *timezone, errTimezone))
+		}
+	}
+
+	var names []string
+	for _, searchAttribute := range parameters.Action.StartWorkflow.SearchAttributes {
+		names = append(names, searchAttribute.Name)
+	}
+	errs = append(errs, validateUnique(forProvider.Child("action", "startWorkflow", "searchAttributes"), names)...)
+
+	return nil, errs
+}
+
+func validateWorkerBuildIdCompatibility(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.WorkerBuildIdCompatibility)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	parameters := cr.Spec.ForProvider
+	errs := validateMatches(forProvider.Child("temporalNamespaceName"), parameters.TemporalNamespaceName, namespaceNamePattern, errNamespaceName)
+
+	sets := map[string]int{}
+	for i, versionSet := range parameters.VersionSets {
+		path := forProvider.Child("versionSets").Index(i).Child("buildIds")
+		errs = append(errs, validateUnique(path, versionSet.BuildIds)...)
+
+		for j, buildId := range versionSet.BuildIds {
+			if set, ok := sets[buildId]; ok && set != i {
+				errs = append(errs, field.Invalid(path.Index(j), buildId, errBuildIdInSets))
+			}
+			sets[buildId] = i
+		}
+	}
+
+	return nil, errs
+}
+
+func validateTaskQueue(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.TaskQueue)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	return nil, validateMatches(forProvider.Child("temporalNamespaceName"), cr.Spec.ForProvider.TemporalNamespaceName, namespaceNamePattern, errNamespaceName)
+}
+
+func validateNamespaceFailover(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.NamespaceFailover)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	return nil, validateMatches(forProvider.Child("temporalNamespaceName"), cr.Spec.ForProvider.TemporalNamespaceName, namespaceNamePattern, errNamespaceName)
+}
+
+func validateRemoteCluster(obj runtime.Object, _ runtime.Object) (admission.Warnings, field.ErrorList) {
+	cr, ok := obj.(*v1alpha1.RemoteCluster)
+	if !ok {
+		return nil, field.ErrorList{field.InternalError(forProvider, errors.New(errUnexpectedType))}
+	}
+
+	address := cr.Spec.ForProvider.FrontendAddress
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || host == "" {
+		return nil, field.ErrorList{field.Invalid(forProvider.Child("frontendAddress"), address, errFrontendAddress)}
+	}
+
+	if number, err := strconv.Atoi(port); err != nil || number < 1 || number > 65535 {
+		return nil, field.ErrorList{field.Invalid(forProvider.Child("frontendAddress"), address, errFrontendAddress)}
+	}
+	return nil, nil
+}
+
+func defaultTemporalNamespace(obj runtime.Object) {
+	cr, ok := obj.(*v1alpha1.TemporalNamespace)
+	if !ok {
+		return
+	}
+
+	parameters := &cr.Spec.ForProvider
+	defaultString(&parameters.AdoptionPolicy, defaultAdoptionPolicy)
+	defaultString(&parameters.DataMergeStrategy, defaultDataMergeStrategy)
+	defaultString(&parameters.HistoryArchivalState, archivalStateDisabled)
+	defaultString(&parameters.VisibilityArchivalState, archivalStateDisabled)
+
+	// The retention has no default in the spec, because only one of its
+	// fields can be set. Its default of 30 days is applied by the controller.
+}
+
+func defaultSchedule(obj runtime.Object) {
+	cr, ok := obj.(*v1alpha1.Schedule)
+	if !ok {
+		return
+	}
+
+	policies := &cr.Spec.ForProvider.Policies
+	defaultString(&policies.OverlapPolicy, defaultOverlapPolicy)
+
+	if policies.CatchupWindow == nil {
+		policies.CatchupWindow = &metav1.Duration{Duration: defaultCatchupWindow}
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+func ptr(value string) *string {
+	return &value
+}
+
+// expectErrors validates obj and asserts the number of errors and warnings.
+func expectErrors(t *testing.T, validate validateFn, obj runtime.Object, old runtime.Object, errors int, warnings int) {
+	t.Helper()
+
+	w, errs := validate(obj, old)
+	if len(errs) != errors {
+		t.Errorf("Expected %d errors, got %v", errors, errs)
+	}
+	if len(w) != warnings {
+		t.Errorf("Expected %d warnings, got %v", warnings, w)
+	}
+}
+
+func TestValidateUnexpectedType(t *testing.T) {
+	expectErrors(t, validateTemporalNamespace, &v1alpha1.SearchAttribute{}, nil, 1, 0)
+}
+
+func TestValidateTemporalNamespace(t *testing.T) {
+	namespace := func(state string, uri string, aliases map[string]string) *v1alpha1.TemporalNamespace {
+		cr := &v1alpha1.TemporalNamespace{}
+		cr.Spec.ForProvider.HistoryArchivalState = state
+		cr.Spec.ForProvider.HistoryArchivalUri = ptr(uri)
+		cr.Spec.ForProvider.CustomSearchAttributeAliases = &aliases
+		return cr
+	}
+
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "file:///tmp/history", map[string]string{"Keyword01": "Customer"}), nil, 0, 0)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "s3://bucket/history", nil), nil, 0, 0)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "", nil), nil, 0, 0)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "http://bucket/history", nil), nil, 1, 0)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "file://tmp", nil), nil, 1, 0)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "gs:///history", nil), nil, 1, 0)
+	expectErrors(t, validateTemporalNamespace, namespace("Disabled", "file:///tmp/history", nil), nil, 0, 1)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "file:///tmp/history", map[string]string{"Keyword01": "Customer", "Keyword02": "Customer"}), nil, 1, 0)
+
+	old := namespace("Enabled", "file:///tmp/history", nil)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "file:///tmp/history", nil), old, 0, 0)
+	expectErrors(t, validateTemporalNamespace, namespace("Enabled", "file:///tmp/other", nil), old, 0, 1)
+}
+
+func TestValidateSearchAttribute(t *testing.T) {
+	searchAttribute := func(name *string, names ...string) *v1alpha1.SearchAttribute {
+		cr := &v1alpha1.SearchAttribute{}
+		cr.Spec.ForProvider.TemporalNamespaceName = name
+		cr.Spec.ForProvider.TemporalNamespaceNames = names
+		return cr
+	}
+
+	expectErrors(t, validateSearchAttribute, searchAttribute(ptr("default")), nil, 0, 0)
+	expectErrors(t, validateSearchAttribute, searchAttribute(nil, "ns1", "ns2"), nil, 0, 0)
+	expectErrors(t, validateSearchAttribute, searchAttribute(ptr("-default")), nil, 1, 0)
+	expectErrors(t, validateSearchAttribute, searchAttribute(nil, "ns1", "ns 2", "ns1"), nil, 2, 0)
+
+	named := func(name string) *v1alpha1.SearchAttribute {
+		cr := searchAttribute(ptr("default"))
+		cr.Spec.ForProvider.Name = name
+		return cr
+	}
+
+	expectErrors(t, validateSearchAttribute, named("CustomerId"), nil, 0, 0)
+	expectErrors(t, validateSearchAttribute, named("Customer Id"), nil, 1, 0)
+	expectErrors(t, validateSearchAttribute, named("WorkflowId"), nil, 1, 0)
+	expectErrors(t, validateSearchAttribute, named("TemporalChangeVersion"), named("CustomerId"), 1, 0)
+}
+
+func TestValidateSearchAttributeSet(t *testing.T) {
+	searchAttributeSet := func(names ...string) *v1alpha1.SearchAttributeSet {
+		cr := &v1alpha1.SearchAttributeSet{}
+		cr.Spec.ForProvider.TemporalNamespaceName = ptr("default")
+		cr.Spec.ForProvider.SearchAttributes = map[string]string{}
+		for _, name := range names {
+			cr.Spec.ForProvider.SearchAttributes[name] = "Keyword"
+		}
+		return cr
+	}
+
+	expectErrors(t, validateSearchAttributeSet, searchAttributeSet("Customer", "Order_Id"), nil, 0, 0)
+	expectErrors(t, validateSearchAttributeSet, searchAttributeSet("1Customer", "Order Id"), nil, 2, 0)
+	expectErrors(t, validateSearchAttributeSet, searchAttributeSet("Customer", "BuildIds", "TemporalScheduledById"), nil, 2, 0)
+}
+
+func TestValidateSchedule(t *testing.T) {
+	schedule := func(timezone string, names ...string) *v1alpha1.Schedule {
+		cr := &v1alpha1.Schedule{}
+		cr.Spec.ForProvider.TemporalNamespaceName = ptr("default")
+		cr.Spec.ForProvider.Spec.TimezoneName = ptr(timezone)
+		for _, name := range names {
+			cr.Spec.ForProvider.Action.StartWorkflow.SearchAttributes = append(cr.Spec.ForProvider.Action.StartWorkflow.SearchAttributes,
+				v1alpha1.ScheduleSearchAttribute{Name: name, Type: "Keyword"})
+		}
+		return cr
+	}
+
+	expectErrors(t, validateSchedule, schedule("Europe/Berlin", "Customer", "Order"), nil, 0, 0)
+	expectErrors(t, validateSchedule, schedule(""), nil, 0, 0)
+	expectErrors(t, validateSchedule, schedule("Europe/Nowhere"), nil, 1, 0)
+	expectErrors(t, validateSchedule, schedule("UTC", "Customer", "Customer"), nil, 1, 0)
+}
+
+func TestValidateWorkerBuildIdCompatibility(t *testing.T) {
+	compatibility := func(sets ...[]string) *v1alpha1.WorkerBuildIdCompatibility {
+		cr := &v1alpha1.WorkerBuildIdCompatibility{}
+		cr.Spec.ForProvider.TemporalNamespaceName = ptr("default")
+		for _, set := range sets {
+			cr.Spec.ForProvider.VersionSets = append(cr.Spec.ForProvider.VersionSets, v1alpha1.BuildIdVersionSet{BuildIds: set})
+		}
+		return cr
+	}
+
+	expectErrors(t, validateWorkerBuildIdCompatibility, compatibility([]string{"1.0", "1.1"}, []string{"2.0"}), nil, 0, 0)
+	expectErrors(t, validateWorkerBuildIdCompatibility, compatibility([]string{"1.0", "1.0"}), nil, 1, 0)
+	expectErrors(t, validateWorkerBuildIdCompatibility, compatibility([]string{"1.0"}, []string{"2.0", "1.0"}), nil, 1, 0)
+}
+
+func TestValidateTaskQueue(t *testing.T) {
+	taskQueue := func(name string) *v1alpha1.TaskQueue {
+		cr := &v1alpha1.TaskQueue{}
+		cr.Spec.ForProvider.TemporalNamespaceName = ptr(name)
+		return cr
+	}
+
+	expectErrors(t, validateTaskQueue, taskQueue("default"), nil, 0, 0)
+	expectErrors(t, validateTaskQueue, taskQueue("default/ns"), nil, 1, 0)
+}
+
+func TestValidateNamespaceFailover(t *testing.T) {
+	failover := func(name string) *v1alpha1.NamespaceFailover {
+		cr := &v1alpha1.NamespaceFailover{}
+		cr.Spec.ForProvider.TemporalNamespaceName = ptr(name)
+		return cr
+	}
+
+	expectErrors(t, validateNamespaceFailover, failover("default"), nil, 0, 0)
+	expectErrors(t, validateNamespaceFailover, failover("_default"), nil, 1, 0)
+}
+
+func TestValidateRemoteCluster(t *testing.T) {
+	remoteCluster := func(address string) *v1alpha1.RemoteCluster {
+		cr := &v1alpha1.RemoteCluster{}
+		cr.Spec.ForProvider.FrontendAddress = address
+		return cr
+	}
+
+	expectErrors(t, validateRemoteCluster, remoteCluster("temporal-frontend.cluster-b:7233"), nil, 0, 0)
+	expectErrors(t, validateRemoteCluster, remoteCluster("[::1]:7233"), nil, 0, 0)
+	expectErrors(t, validateRemoteCluster, remoteCluster("temporal-frontend.cluster-b"), nil, 1, 0)
+	expectErrors(t, validateRemoteCluster, remoteCluster(":7233"), nil, 1, 0)
+	expectErrors(t, validateRemoteCluster, remoteCluster("temporal-frontend.cluster-b:0"), nil, 1, 0)
+	expectErrors(t, validateRemoteCluster, remoteCluster("temporal-frontend.cluster-b:http"), nil, 1, 0)
+}
+
+func TestDefaultTemporalNamespace(t *testing.T) {
+	cr := &v1alpha1.TemporalNamespace{}
+	defaultTemporalNamespace(cr)
+
+	parameters := cr.Spec.ForProvider
+	if parameters.AdoptionPolicy != "Adopt" || parameters.DataMergeStrategy != "Replace" ||
+		parameters.HistoryArchivalState != "Disabled" || parameters.VisibilityArchivalState != "Disabled" {
+		t.Errorf("Expected defaults to be set, got %+v", parameters)
+	}
+
+	// Like the CRD, the webhook does not default the retention, which can be
+	// set by either of two fields.
+	if parameters.WorkflowExecutionRetentionDays != 0 || parameters.WorkflowExecutionRetention != nil {
+		t.Errorf("Expected retention not to be defaulted, got %+v", parameters)
+	}
+
+	cr = &v1alpha1.TemporalNamespace{}
+	cr.Spec.ForProvider.HistoryArchivalState = "Enabled"
+	cr.Spec.ForProvider.WorkflowExecutionRetention = &metav1.Duration{Duration: 36 * time.Hour}
+	defaultTemporalNamespace(cr)
+
+	parameters = cr.Spec.ForProvider
+	if parameters.HistoryArchivalState != "Enabled" || parameters.WorkflowExecutionRetentionDays != 0 {
+		t.Errorf("Expected set parameters to be kept, got %+v", parameters)
+	}
+}
+
+func TestDefaultSchedule(t *testing.T) {
+	cr := &v1alpha1.Schedule{}
+	defaultSchedule(cr)
+
+	policies := cr.Spec.ForProvider.Policies
+	if policies.OverlapPolicy != "Skip" || policies.CatchupWindow == nil || policies.CatchupWindow.Duration != 8760*time.Hour {
+		t.Errorf("Expected defaults to be set, got %+v", policies)
+	}
+
+	cr = &v1alpha1.Schedule{}
+	cr.Spec.ForProvider.Policies.OverlapPolicy = "AllowAll"
+	defaultSchedule(cr)
+
+	if cr.Spec.ForProvider.Policies.OverlapPolicy != "AllowAll" {
+		t.Errorf("Expected OverlapPolicy to be kept, got %s", cr.Spec.ForProvider.Policies.OverlapPolicy)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/denniskniep/provider-temporal/internal/providerref"
+)
+
+// defaultFn sets the defaults of the unset parameters of a managed resource.
+type defaultFn func(obj runtime.Object)
+
+// A defaulter applies the same defaults as the CRDs, also to managed resources,
+// which were applied with a partial spec, e.g. by server-side apply, and
+// migrates the deprecated provider reference.
+type defaulter struct {
+	setDefaults defaultFn
+}
+
+func (d *defaulter) Default(_ context.Context, obj runtime.Object) error {
+	mg, ok := obj.(resource.Managed)
+	if !ok {
+		return errors.New(errUnexpectedType)
+	}
+
+	if ref, ok := obj.(providerref.Referencer); ok {
+		providerref.Migrate(ref)
+	}
+
+	if ref := mg.GetProviderConfigReference(); ref == nil || ref.Name == "" {
+		mg.SetProviderConfigReference(&xpv1.Reference{Name: providerref.DefaultName})
+	}
+
+	if d.setDefaults != nil {
+		d.setDefaults(obj)
+	}
+	return nil
+}
+
+// defaultString sets the value to the default, if it is empty.
+func defaultString(value *string, def string) {
+	if *value == "" {
+		*value = def
+	}
+}
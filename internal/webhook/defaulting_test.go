@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+func TestDefaulterProviderConfigRef(t *testing.T) {
+	d := &defaulter{}
+
+	cr := &v1alpha1.TaskQueue{}
+	if err := d.Default(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+	if ref := cr.GetProviderConfigReference(); ref == nil || ref.Name != "default" {
+		t.Errorf("Expected providerConfigRef default, got %v", ref)
+	}
+
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "other"})
+	if err := d.Default(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+	if ref := cr.GetProviderConfigReference(); ref.Name != "other" {
+		t.Errorf("Expected providerConfigRef to be kept, got %v", ref)
+	}
+}
+
+func TestDefaulterMigratesProviderRef(t *testing.T) {
+	d := &defaulter{setDefaults: defaultTemporalNamespace}
+
+	cr := &v1alpha1.TemporalNamespace{}
+	cr.SetProviderReference(&xpv1.Reference{Name: "legacy"})
+	if err := d.Default(context.Background(), cr); err != nil {
+		t.Fatal(err)
+	}
+
+	if cr.GetProviderReference() != nil || cr.GetProviderConfigReference().Name != "legacy" {
+		t.Errorf("Expected providerRef to be migrated, got %v", cr.GetProviderConfigReference())
+	}
+	if cr.Spec.ForProvider.AdoptionPolicy != "Adopt" {
+		t.Error("Expected defaults of the kind to be set")
+	}
+}
+
+func TestDefaulterUnexpectedType(t *testing.T) {
+	if err := (&defaulter{}).Default(context.Background(), &v1alpha1.TemporalNamespaceList{}); err == nil {
+		t.Error("Expected error for object, which is not a managed resource")
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"regexp"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	errUnexpectedType = "unexpected type"
+	errNotUnique      = "must be unique"
+	errInvalidPem     = "must contain at least one PEM encoded certificate"
+)
+
+// validateFn validates a created or updated managed resource. The old managed
+// resource is nil, if it is created.
+type validateFn func(obj runtime.Object, old runtime.Object) (admission.Warnings, field.ErrorList)
+
+// A validator enforces the rules of a kind, which the CRD validation can not
+// express, e.g. rules, which parse values.
+type validator struct {
+	kind     schema.GroupKind
+	validate validateFn
+}
+
+func (v *validator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.check(obj, nil)
+}
+
+func (v *validator) ValidateUpdate(_ context.Context, old runtime.Object, obj runtime.Object) (admission.Warnings, error) {
+	return v.check(obj, old)
+}
+
+func (v *validator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *validator) check(obj runtime.Object, old runtime.Object) (admission.Warnings, error) {
+	warnings, errs := v.validate(obj, old)
+	if len(errs) == 0 {
+		return warnings, nil
+	}
+
+	name := ""
+	if accessor, err := meta.Accessor(obj); err == nil {
+		name = accessor.GetName()
+	}
+	return warnings, kerrors.NewInvalid(v.kind, name, errs)
+}
+
+// forProvider is the path of the parameters of all managed resources.
+var forProvider = field.NewPath("spec", "forProvider")
+
+// validateUnique returns an error for each value, which occurs more than once.
+func validateUnique(path *field.Path, values []string) field.ErrorList {
+	var errs field.ErrorList
+	seen := map[string]bool{}
+	for i, value := range values {
+		if seen[value] {
+			errs = append(errs, field.Invalid(path.Index(i), value, errNotUnique))
+		}
+		seen[value] = true
+	}
+	return errs
+}
+
+// validateMatches returns an error, if the value is set and does not match the
+// pattern.
+func validateMatches(path *field.Path, value *string, pattern *regexp.Regexp, detail string) field.ErrorList {
+	if value == nil || *value == "" || pattern.MatchString(*value) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(path, *value, detail)}
+}
+
+// validateCertificates returns an error, if the value is set and does not
+// contain a valid PEM encoded certificate.
+func validateCertificates(path *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+
+	rest := []byte(value)
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return field.ErrorList{field.Invalid(path, "<certificate>", err.Error())}
+		}
+		found = true
+	}
+
+	if !found {
+		return field.ErrorList{field.Invalid(path, "<certificate>", errInvalidPem)}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook contains the admission webhooks of the temporal provider.
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	cloud "github.com/denniskniep/provider-temporal/apis/cloud/v1alpha1"
+	core "github.com/denniskniep/provider-temporal/apis/core/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-namespacefailover,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=namespacefailovers,verbs=create;update,versions=v1alpha1,name=namespacefailovers.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-remotecluster,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=remoteclusters,verbs=create;update,versions=v1alpha1,name=remoteclusters.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-schedule,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=schedules,verbs=create;update,versions=v1alpha1,name=schedules.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-searchattribute,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=searchattributes,verbs=create;update,versions=v1alpha1,name=searchattributes.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-searchattributeset,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=searchattributesets,verbs=create;update,versions=v1alpha1,name=searchattributesets.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-taskqueue,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=taskqueues,verbs=create;update,versions=v1alpha1,name=taskqueues.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-temporalnamespace,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=temporalnamespaces,verbs=create;update,versions=v1alpha1,name=temporalnamespaces.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-core-temporal-crossplane-io-v1alpha1-workerbuildidcompatibility,mutating=true,failurePolicy=ignore,sideEffects=None,groups=core.temporal.crossplane.io,resources=workerbuildidcompatibilities,verbs=create;update,versions=v1alpha1,name=workerbuildidcompatibilities.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudapikey,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudapikeys,verbs=create;update,versions=v1alpha1,name=cloudapikeys.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudmetricsendpoint,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudmetricsendpoints,verbs=create;update,versions=v1alpha1,name=cloudmetricsendpoints.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudnamespace,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnamespaces,verbs=create;update,versions=v1alpha1,name=cloudnamespaces.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudnamespaceaccess,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnamespaceaccesses,verbs=create;update,versions=v1alpha1,name=cloudnamespaceaccesses.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudnamespaceexportsink,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnamespaceexportsinks,verbs=create;update,versions=v1alpha1,name=cloudnamespaceexportsinks.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudnexusendpoint,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnexusendpoints,verbs=create;update,versions=v1alpha1,name=cloudnexusendpoints.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudserviceaccount,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudserviceaccounts,verbs=create;update,versions=v1alpha1,name=cloudserviceaccounts.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-clouduser,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudusers,verbs=create;update,versions=v1alpha1,name=cloudusers.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-cloud-temporal-crossplane-io-v1alpha1-cloudusergroup,mutating=true,failurePolicy=ignore,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudusergroups,verbs=create;update,versions=v1alpha1,name=cloudusergroups.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-namespacefailover,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=namespacefailovers,verbs=create;update,versions=v1alpha1,name=namespacefailovers.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-remotecluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=remoteclusters,verbs=create;update,versions=v1alpha1,name=remoteclusters.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-schedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=schedules,verbs=create;update,versions=v1alpha1,name=schedules.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-searchattribute,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=searchattributes,verbs=create;update,versions=v1alpha1,name=searchattributes.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-searchattributeset,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=searchattributesets,verbs=create;update,versions=v1alpha1,name=searchattributesets.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-taskqueue,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=taskqueues,verbs=create;update,versions=v1alpha1,name=taskqueues.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-temporalnamespace,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=temporalnamespaces,verbs=create;update,versions=v1alpha1,name=temporalnamespaces.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-core-temporal-crossplane-io-v1alpha1-workerbuildidcompatibility,mutating=false,failurePolicy=fail,sideEffects=None,groups=core.temporal.crossplane.io,resources=workerbuildidcompatibilities,verbs=create;update,versions=v1alpha1,name=workerbuildidcompatibilities.core.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudapikey,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudapikeys,verbs=create;update,versions=v1alpha1,name=cloudapikeys.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudmetricsendpoint,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudmetricsendpoints,verbs=create;update,versions=v1alpha1,name=cloudmetricsendpoints.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudnamespace,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnamespaces,verbs=create;update,versions=v1alpha1,name=cloudnamespaces.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudnamespaceaccess,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnamespaceaccesses,verbs=create;update,versions=v1alpha1,name=cloudnamespaceaccesses.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudnamespaceexportsink,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnamespaceexportsinks,verbs=create;update,versions=v1alpha1,name=cloudnamespaceexportsinks.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudnexusendpoint,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudnexusendpoints,verbs=create;update,versions=v1alpha1,name=cloudnexusendpoints.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudserviceaccount,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudserviceaccounts,verbs=create;update,versions=v1alpha1,name=cloudserviceaccounts.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-clouduser,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudusers,verbs=create;update,versions=v1alpha1,name=cloudusers.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-cloud-temporal-crossplane-io-v1alpha1-cloudusergroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=cloud.temporal.crossplane.io,resources=cloudusergroups,verbs=create;update,versions=v1alpha1,name=cloudusergroups.cloud.temporal.crossplane.io,admissionReviewVersions=v1
+
+// Setup adds the defaulting webhooks, which also migrate the deprecated
+// spec.providerRef to spec.providerConfigRef, and the validating webhooks of
+// all managed resources to the supplied manager.
+func Setup(mgr ctrl.Manager) error {
+	for _, kind := range []struct {
+		obj      runtime.Object
+		kind     schema.GroupKind
+		validate validateFn
+		defaults defaultFn
+	}{
+		{&core.NamespaceFailover{}, schema.GroupKind{Group: core.Group, Kind: core.NamespaceFailoverKind}, validateNamespaceFailover, nil},
+		{&core.RemoteCluster{}, schema.GroupKind{Group: core.Group, Kind: core.RemoteClusterKind}, validateRemoteCluster, nil},
+		{&core.Schedule{}, schema.GroupKind{Group: core.Group, Kind: core.ScheduleKind}, validateSchedule, defaultSchedule},
+		{&core.SearchAttribute{}, schema.GroupKind{Group: core.Group, Kind: core.SearchAttributeKind}, validateSearchAttribute, nil},
+		{&core.SearchAttributeSet{}, schema.GroupKind{Group: core.Group, Kind: core.SearchAttributeSetKind}, validateSearchAttributeSet, nil},
+		{&core.TaskQueue{}, schema.GroupKind{Group: core.Group, Kind: core.TaskQueueKind}, validateTaskQueue, nil},
+		{&core.TemporalNamespace{}, schema.GroupKind{Group: core.Group, Kind: core.TemporalNamespaceKind}, validateTemporalNamespace, defaultTemporalNamespace},
+		{&core.WorkerBuildIdCompatibility{}, schema.GroupKind{Group: core.Group, Kind: core.WorkerBuildIdCompatibilityKind}, validateWorkerBuildIdCompatibility, nil},
+		{&cloud.CloudApiKey{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudApiKeyKind}, validateCloudApiKey, nil},
+		{&cloud.CloudMetricsEndpoint{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudMetricsEndpointKind}, validateCloudMetricsEndpoint, nil},
+		{&cloud.CloudNamespace{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudNamespaceKind}, validateCloudNamespace, defaultCloudNamespace},
+		{&cloud.CloudNamespaceAccess{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudNamespaceAccessKind}, validateCloudNamespaceAccess, defaultCloudNamespaceAccess},
+		{&cloud.CloudNamespaceExportSink{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudNamespaceExportSinkKind}, validateCloudNamespaceExportSink, nil},
+		{&cloud.CloudNexusEndpoint{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudNexusEndpointKind}, validateCloudNexusEndpoint, nil},
+		{&cloud.CloudServiceAccount{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudServiceAccountKind}, validateCloudServiceAccount, defaultCloudServiceAccount},
+		{&cloud.CloudUser{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudUserKind}, validateCloudUser, defaultCloudUser},
+		{&cloud.CloudUserGroup{}, schema.GroupKind{Group: cloud.Group, Kind: cloud.CloudUserGroupKind}, validateCloudUserGroup, defaultCloudUserGroup},
+	} {
+		if err := ctrl.NewWebhookManagedBy(mgr).
+			For(kind.obj).
+			WithDefaulter(&defaulter{setDefaults: kind.defaults}).
+			WithValidator(&validator{kind: kind.kind, validate: kind.validate}).
+			Complete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}